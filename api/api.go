@@ -5,23 +5,263 @@ import (
 	"log"
 	"net/http"
 	"sort"
+	"strconv"
 	"time"
 
+	"mcAfkGo/auth"
 	"mcAfkGo/frontend"
+	"mcAfkGo/net/inspect"
+	"mcAfkGo/net/queue"
 )
 
-func StartAPI(address string, getPlayers func(string) ([]string, error), getLastSeen func() map[string]time.Time) {
+type Status struct {
+	ID      string    `json:"id"`
+	Address string    `json:"address"`
+	Name    string    `json:"name,omitempty"`
+	State   string    `json:"state"`
+	Since   time.Time `json:"since"`
+	Error   string    `json:"error,omitempty"`
+	// Alert is a problem that needs a look, like the bot not getting back
+	// to its AFK spot.
+	Alert string `json:"alert,omitempty"`
+	// Queues are the packet queues of the session while online.
+	Queues *QueueStats `json:"queues,omitempty"`
+
+	Auth       *auth.TokenState      `json:"auth,omitempty"`
+	DeviceAuth *auth.DeviceAuthState `json:"device_auth,omitempty"`
+}
+
+type QueueStats struct {
+	Receive queue.Stats `json:"receive"`
+	Send    queue.Stats `json:"send"`
+}
+
+// Death is a death of a bot, with the death message rendered in English.
+type Death struct {
+	Time       time.Time `json:"time"`
+	Message    string    `json:"message"`
+	Cause      string    `json:"cause,omitempty"`
+	Killer     string    `json:"killer,omitempty"`
+	KillerUUID string    `json:"killer_uuid,omitempty"`
+	Dimension  string    `json:"dimension"`
+	X          float64   `json:"x"`
+	Y          float64   `json:"y"`
+	Z          float64   `json:"z"`
+}
+
+type Bot interface {
+	ID() string
+	Address() string
+	Status() Status
+	LastSeen() map[string]time.Time
+	RestartDeviceAuth() error
+	RecentPackets(n int) []inspect.Packet
+	Deaths() []Death
+	Goto(x, y, z float64) error
+	StopGoto() error
+}
+
+// StartAPI serves the dashboard and the per-bot endpoints under
+// /bots/{id}/. The unprefixed endpoints are kept for the first bot so
+// existing clients of the single-bot API keep working.
+func StartAPI(bots []Bot, getPlayers func(string) ([]string, error)) {
+	byID := make(map[string]Bot, len(bots))
+	for _, b := range bots {
+		byID[b.ID()] = b
+	}
+
 	go func() {
 		http.HandleFunc("/", frontend.IndexHandler())
-		http.HandleFunc("/online-players", onlinePlayersHandler(address, getPlayers))
-		http.HandleFunc("/online-players/v2", onlinePlayersV2Handler(address, getPlayers))
-		http.HandleFunc("/last-seen", lastSeenHandler(getLastSeen))
+		http.HandleFunc("/bots", overviewHandler(bots))
+		http.HandleFunc("/bots/{id}", botHandler(byID, statusHandler))
+		http.HandleFunc("POST /bots/{id}/device-auth/restart", botHandler(byID, restartDeviceAuthHandler))
+		http.HandleFunc("/bots/{id}/online-players", botHandler(byID, func(b Bot) http.HandlerFunc {
+			return onlinePlayersHandler(b.Address(), getPlayers)
+		}))
+		http.HandleFunc("/bots/{id}/online-players/v2", botHandler(byID, func(b Bot) http.HandlerFunc {
+			return onlinePlayersV2Handler(b.Address(), getPlayers)
+		}))
+		http.HandleFunc("/bots/{id}/last-seen", botHandler(byID, func(b Bot) http.HandlerFunc {
+			return lastSeenHandler(b.LastSeen)
+		}))
+		http.HandleFunc("/bots/{id}/debug/packets", botHandler(byID, packetsHandler))
+		http.HandleFunc("/bots/{id}/deaths", botHandler(byID, deathsHandler))
+		http.HandleFunc("POST /bots/{id}/goto", botHandler(byID, gotoHandler))
+		http.HandleFunc("DELETE /bots/{id}/goto", botHandler(byID, stopGotoHandler))
+
+		if len(bots) > 0 {
+			http.HandleFunc("/online-players", onlinePlayersHandler(bots[0].Address(), getPlayers))
+			http.HandleFunc("/online-players/v2", onlinePlayersV2Handler(bots[0].Address(), getPlayers))
+			http.HandleFunc("/last-seen", lastSeenHandler(bots[0].LastSeen))
+			http.HandleFunc("/debug/packets", packetsHandler(bots[0]))
+			http.HandleFunc("/deaths", deathsHandler(bots[0]))
+		}
 
 		log.Println("API server listening on :8080")
 		log.Fatal(http.ListenAndServe(":8080", nil))
 	}()
 }
 
+func botHandler(bots map[string]Bot, handler func(Bot) http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, ok := bots[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Unknown bot")
+
+			return
+		}
+
+		handler(b)(w, r)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{Error: message})
+	if err != nil {
+		log.Println("Failed to write error response:", err)
+	}
+}
+
+func overviewHandler(bots []Bot) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp := struct {
+			Total  int            `json:"total"`
+			States map[string]int `json:"states"`
+			Bots   []Status       `json:"bots"`
+		}{
+			Total:  len(bots),
+			States: make(map[string]int),
+			Bots:   make([]Status, 0, len(bots)),
+		}
+
+		for _, b := range bots {
+			status := b.Status()
+			resp.States[status.State]++
+			resp.Bots = append(resp.Bots, status)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(resp)
+		if err != nil {
+			log.Println("Failed to encode bots overview:", err)
+		}
+	}
+}
+
+func statusHandler(b Bot) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(b.Status())
+		if err != nil {
+			log.Println("Failed to encode bot status:", err)
+		}
+	}
+}
+
+func restartDeviceAuthHandler(b Bot) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := b.RestartDeviceAuth()
+		if err != nil {
+			writeError(w, http.StatusConflict, err.Error())
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		err = json.NewEncoder(w).Encode(b.Status())
+		if err != nil {
+			log.Println("Failed to encode bot status:", err)
+		}
+	}
+}
+
+// packetsHandler lists the bot's last packets decoded, oldest first. The
+// optional n parameter limits how many.
+func packetsHandler(b Bot) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := 0
+		if v := r.URL.Query().Get("n"); v != "" {
+			var err error
+			n, err = strconv.Atoi(v)
+			if err != nil || n < 0 {
+				writeError(w, http.StatusBadRequest, "Invalid n")
+
+				return
+			}
+		}
+
+		packets := b.RecentPackets(n)
+		if packets == nil {
+			writeError(w, http.StatusNotFound, "Packet debugging is disabled for this bot")
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(packets)
+		if err != nil {
+			log.Println("Failed to encode packets:", err)
+		}
+	}
+}
+
+// deathsHandler lists the bot's last deaths, oldest first.
+func deathsHandler(b Bot) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(b.Deaths())
+		if err != nil {
+			log.Println("Failed to encode deaths:", err)
+		}
+	}
+}
+
+// gotoHandler has the bot walk to the coordinates in the JSON body, which
+// become its AFK spot once there.
+func gotoHandler(b Bot) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var target struct {
+			X, Y, Z *float64
+		}
+
+		err := json.NewDecoder(r.Body).Decode(&target)
+		if err != nil || target.X == nil || target.Y == nil || target.Z == nil {
+			writeError(w, http.StatusBadRequest, "Expected {\"x\": ..., \"y\": ..., \"z\": ...}")
+
+			return
+		}
+
+		err = b.Goto(*target.X, *target.Y, *target.Z)
+		if err != nil {
+			writeError(w, http.StatusConflict, err.Error())
+
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func stopGotoHandler(b Bot) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := b.StopGoto()
+		if err != nil {
+			writeError(w, http.StatusConflict, err.Error())
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
 func onlinePlayersHandler(address string, getPlayers func(string) ([]string, error)) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		players, err := getPlayers(address)
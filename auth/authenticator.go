@@ -0,0 +1,340 @@
+package auth
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Profile struct {
+	Name        string
+	UUID        string
+	AccessToken string
+}
+
+// Authenticator supplies the profile a client logs in with and performs the
+// session server handshake when the server asks for online authentication.
+type Authenticator interface {
+	Authenticate() (Profile, error)
+	JoinServer(profile Profile, serverHash string) error
+}
+
+const (
+	TokenUnknown       = "unknown"
+	TokenValid         = "valid"
+	TokenRefreshing    = "refreshing"
+	TokenLoginRequired = "login_required"
+	TokenError         = "error"
+)
+
+// TokenState describes the Minecraft token an authenticator currently holds.
+type TokenState struct {
+	State     string    `json:"state"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// MicrosoftAuthenticator logs in with a Microsoft account. The token is kept
+// in memory and in TokenFile, and KeepFresh renews it before it expires so
+// reconnects don't have to wait for a login.
+type MicrosoftAuthenticator struct {
+	Client    *Client
+	ClientID  string
+	TokenFile string
+
+	// RefreshMargin is how long before expiry the token gets renewed.
+	RefreshMargin time.Duration
+
+	// mu serializes logins and refreshes and guards cache. It is held for
+	// the whole device code flow, so the state reported to callers lives
+	// under stateMu instead.
+	mu    sync.Mutex
+	cache *TokenCache
+
+	stateMu      sync.Mutex
+	state        TokenState
+	device       *DeviceAuthState
+	cancelDevice context.CancelCauseFunc
+}
+
+// errDeviceAuthRestarted cancels a device code flow that is started over.
+var errDeviceAuthRestarted = errors.New("device code login restarted")
+
+func NewMicrosoftAuthenticator(client *Client, clientID, tokenFile string) *MicrosoftAuthenticator {
+	return &MicrosoftAuthenticator{
+		Client:        client,
+		ClientID:      clientID,
+		TokenFile:     tokenFile,
+		RefreshMargin: DefaultRefreshMargin,
+		state:         TokenState{State: TokenUnknown},
+	}
+}
+
+func (a *MicrosoftAuthenticator) Authenticate() (Profile, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.cache != nil && a.cache.ValidFor(a.RefreshMargin) {
+		return a.cache.Profile(), nil
+	}
+
+	client := clientOrDefault(a.Client)
+
+	cache, err := client.LoadToken(a.ClientID, a.TokenFile, a.RefreshMargin)
+	if errors.Is(err, ErrTokenCacheUnreadable) {
+		a.setState(TokenError, time.Time{}, err)
+
+		return Profile{}, err
+	}
+
+	if err != nil {
+		log.Printf("Cached token unusable: %v, will re-authenticate", err)
+		a.setState(TokenLoginRequired, time.Time{}, err)
+
+		cache, err = a.deviceLogin(client)
+		if err != nil {
+			a.setState(TokenLoginRequired, time.Time{}, err)
+
+			return Profile{}, err
+		}
+	}
+
+	a.cache = cache
+	a.setState(TokenValid, cache.ExpiresAt, nil)
+
+	return cache.Profile(), nil
+}
+
+// deviceLogin runs the device code flow, starting it over every time
+// RestartDeviceAuth abandons it.
+func (a *MicrosoftAuthenticator) deviceLogin(client *Client) (*TokenCache, error) {
+	for {
+		ctx, cancel := context.WithCancelCause(context.Background())
+
+		a.stateMu.Lock()
+		a.cancelDevice = cancel
+		a.stateMu.Unlock()
+
+		cache, err := client.DeviceLogin(ctx, a.ClientID, a.TokenFile, a.setDeviceState)
+
+		a.stateMu.Lock()
+		a.cancelDevice = nil
+		a.stateMu.Unlock()
+
+		restarted := errors.Is(context.Cause(ctx), errDeviceAuthRestarted)
+		cancel(nil)
+
+		if err != nil && restarted {
+			log.Println("Device code login restarted")
+
+			continue
+		}
+
+		return cache, err
+	}
+}
+
+// RestartDeviceAuth abandons the device code login in progress and starts
+// a new one with a fresh code. It reports whether a login was running.
+func (a *MicrosoftAuthenticator) RestartDeviceAuth() bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+
+	if a.cancelDevice == nil {
+		return false
+	}
+
+	a.cancelDevice(errDeviceAuthRestarted)
+
+	return true
+}
+
+// DeviceAuth returns the state of the last device code login, or nil if
+// none was needed yet.
+func (a *MicrosoftAuthenticator) DeviceAuth() *DeviceAuthState {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+
+	if a.device == nil {
+		return nil
+	}
+
+	device := *a.device
+
+	return &device
+}
+
+func (a *MicrosoftAuthenticator) setDeviceState(device DeviceAuthState) {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+
+	a.device = &device
+}
+
+func (a *MicrosoftAuthenticator) JoinServer(profile Profile, serverHash string) error {
+	return clientOrDefault(a.Client).JoinSession(profile, serverHash)
+}
+
+func (a *MicrosoftAuthenticator) State() TokenState {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+
+	return a.state
+}
+
+func (a *MicrosoftAuthenticator) setState(state string, expiresAt time.Time, err error) {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+
+	a.state = TokenState{State: state, ExpiresAt: expiresAt}
+	if err != nil {
+		a.state.Error = err.Error()
+	}
+}
+
+const refreshRetryDelay = time.Minute
+
+// KeepFresh renews the token RefreshMargin before it expires until ctx is
+// done. It only uses the refresh token; when that is revoked the state
+// becomes TokenLoginRequired and the next Authenticate runs the device flow.
+func (a *MicrosoftAuthenticator) KeepFresh(ctx context.Context) {
+	for {
+		wait := refreshRetryDelay
+
+		a.mu.Lock()
+		if a.cache != nil && a.State().State == TokenValid {
+			wait = time.Until(a.cache.ExpiresAt.Add(-a.RefreshMargin))
+		}
+		a.mu.Unlock()
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return
+			case <-timer.C:
+			}
+		}
+
+		a.refresh()
+	}
+}
+
+func (a *MicrosoftAuthenticator) refresh() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.cache == nil || a.State().State == TokenLoginRequired || a.cache.ValidFor(a.RefreshMargin) {
+		return
+	}
+
+	log.Println("Minecraft token is about to expire, refreshing in the background...")
+	a.setState(TokenRefreshing, a.cache.ExpiresAt, nil)
+
+	// Going through the token file picks up a token another process
+	// sharing it has already refreshed.
+	client := clientOrDefault(a.Client)
+
+	var cache *TokenCache
+	var err error
+	if a.TokenFile != "" {
+		cache, err = client.LoadToken(a.ClientID, a.TokenFile, a.RefreshMargin)
+	} else {
+		cache, err = client.RefreshToken(a.ClientID, a.cache.MicrosoftRefreshToken, "")
+	}
+
+	if err != nil {
+		if errors.Is(err, ErrLoginRequired) {
+			log.Printf("Background token refresh failed: %v", err)
+			a.setState(TokenLoginRequired, a.cache.ExpiresAt, err)
+
+			return
+		}
+
+		log.Printf("Background token refresh failed, will retry: %v", err)
+		a.setState(TokenError, a.cache.ExpiresAt, err)
+
+		return
+	}
+
+	a.cache = cache
+	a.setState(TokenValid, cache.ExpiresAt, nil)
+}
+
+// TokenAuthenticator logs in with a Minecraft access token obtained
+// elsewhere. The token is used as is and never refreshed.
+type TokenAuthenticator struct {
+	Client  *Client
+	Profile Profile
+}
+
+func NewTokenAuthenticator(client *Client, profile Profile) *TokenAuthenticator {
+	return &TokenAuthenticator{Client: client, Profile: profile}
+}
+
+func (a *TokenAuthenticator) Authenticate() (Profile, error) {
+	if a.Profile.AccessToken == "" {
+		return Profile{}, errors.New("no access token supplied")
+	}
+
+	if a.Profile.Name == "" || a.Profile.UUID == "" {
+		return Profile{}, errors.New("access token login needs both a name and a UUID")
+	}
+
+	return a.Profile, nil
+}
+
+func (a *TokenAuthenticator) JoinServer(profile Profile, serverHash string) error {
+	return clientOrDefault(a.Client).JoinSession(profile, serverHash)
+}
+
+// OfflineAuthenticator logs in to servers running with online-mode=false.
+// It never contacts the session server.
+type OfflineAuthenticator struct {
+	Name string
+}
+
+func NewOfflineAuthenticator(name string) *OfflineAuthenticator {
+	return &OfflineAuthenticator{Name: name}
+}
+
+func (a *OfflineAuthenticator) Authenticate() (Profile, error) {
+	if a.Name == "" {
+		return Profile{}, errors.New("offline login needs a name")
+	}
+
+	return Profile{Name: a.Name, UUID: OfflineUUID(a.Name).String()}, nil
+}
+
+func (a *OfflineAuthenticator) JoinServer(Profile, string) error {
+	return nil
+}
+
+// OfflineUUID returns the UUID an offline mode server assigns to name, the
+// same as Java's UUID.nameUUIDFromBytes("OfflinePlayer:" + name).
+func OfflineUUID(name string) uuid.UUID {
+	var id uuid.UUID
+
+	sum := md5.Sum([]byte("OfflinePlayer:" + name))
+	copy(id[:], sum[:])
+
+	id[6] = id[6]&0x0f | 0x30
+	id[8] = id[8]&0x3f | 0x80
+
+	return id
+}
+
+func clientOrDefault(c *Client) *Client {
+	if c != nil {
+		return c
+	}
+
+	return DefaultClient
+}
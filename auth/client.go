@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Endpoints holds the base URLs of every service taking part in the login
+// chain. Empty fields fall back to DefaultEndpoints, so a Yggdrasil-compatible
+// server (e.g. authlib-injector) only needs SessionServer overridden.
+type Endpoints struct {
+	MicrosoftLogin    string `json:"microsoft_login,omitempty"`
+	XboxUser          string `json:"xbox_user,omitempty"`
+	XboxXSTS          string `json:"xbox_xsts,omitempty"`
+	MinecraftServices string `json:"minecraft_services,omitempty"`
+	SessionServer     string `json:"session_server,omitempty"`
+}
+
+var DefaultEndpoints = Endpoints{
+	MicrosoftLogin:    "https://login.microsoftonline.com/consumers/oauth2/v2.0",
+	XboxUser:          "https://user.auth.xboxlive.com",
+	XboxXSTS:          "https://xsts.auth.xboxlive.com",
+	MinecraftServices: "https://api.minecraftservices.com",
+	SessionServer:     "https://sessionserver.mojang.com",
+}
+
+func (e Endpoints) withDefaults() Endpoints {
+	if e.MicrosoftLogin == "" {
+		e.MicrosoftLogin = DefaultEndpoints.MicrosoftLogin
+	}
+
+	if e.XboxUser == "" {
+		e.XboxUser = DefaultEndpoints.XboxUser
+	}
+
+	if e.XboxXSTS == "" {
+		e.XboxXSTS = DefaultEndpoints.XboxXSTS
+	}
+
+	if e.MinecraftServices == "" {
+		e.MinecraftServices = DefaultEndpoints.MinecraftServices
+	}
+
+	if e.SessionServer == "" {
+		e.SessionServer = DefaultEndpoints.SessionServer
+	}
+
+	return e
+}
+
+// Client performs every HTTP call of the Microsoft, Xbox, Minecraft services
+// and session server login chain.
+type Client struct {
+	Endpoints  Endpoints
+	HTTPClient *http.Client
+	UserAgent  string
+
+	// Timeout bounds each request. Zero means no limit beyond HTTPClient's.
+	Timeout time.Duration
+
+	// CacheKey encrypts token caches with AES-GCM. Nil writes them as
+	// plain JSON.
+	CacheKey []byte
+}
+
+const (
+	DefaultUserAgent = "go-mc"
+	DefaultTimeout   = 30 * time.Second
+)
+
+var DefaultClient = NewClient(Endpoints{})
+
+func NewClient(endpoints Endpoints) *Client {
+	return &Client{
+		Endpoints:  endpoints.withDefaults(),
+		HTTPClient: http.DefaultClient,
+		UserAgent:  DefaultUserAgent,
+		Timeout:    DefaultTimeout,
+	}
+}
+
+func (c *Client) url(base, path string) string {
+	return strings.TrimRight(base, "/") + path
+}
+
+// do sends req and returns the status code and the whole response body.
+func (c *Client) do(req *http.Request) (int, []byte, error) {
+	if c.Timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.Timeout)
+		defer cancel()
+
+		req = req.WithContext(ctx)
+	}
+
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+
+	return resp.StatusCode, body, nil
+}
+
+func (c *Client) postForm(endpoint string, data url.Values) (int, []byte, error) {
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return c.do(req)
+}
+
+func (c *Client) postJSON(endpoint string, v any) (int, []byte, error) {
+	requestBody, err := json.Marshal(v)
+	if err != nil {
+		return 0, nil, fmt.Errorf("encode request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(requestBody))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	return c.do(req)
+}
+
+func (c *Client) getWithToken(endpoint, token string) (int, []byte, error) {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return c.do(req)
+}
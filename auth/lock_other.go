@@ -0,0 +1,8 @@
+//go:build !unix
+
+package auth
+
+// lockTokenFile is a no-op where flock isn't available.
+func lockTokenFile(string) (unlock func(), err error) {
+	return func() {}, nil
+}
@@ -0,0 +1,36 @@
+//go:build unix
+
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// lockTokenFile takes an exclusive advisory lock on path+".lock" and
+// returns the function releasing it.
+func lockTokenFile(path string) (unlock func(), err error) {
+	if path == "" {
+		return func() {}, nil
+	}
+
+	_ = os.MkdirAll(filepath.Dir(path), 0700)
+
+	f, err := os.OpenFile(path+".lock", os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		_ = f.Close()
+
+		return nil, err
+	}
+
+	return func() {
+		_ = syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		_ = f.Close()
+	}, nil
+}
@@ -1,15 +1,14 @@
 package auth
 
 import (
-	"bytes"
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
 	"net/url"
-	"os"
 	"strings"
 	"time"
 )
@@ -36,36 +35,110 @@ type TokenCache struct {
 	MinecraftAccessToken  string    `json:"minecraft_access_token"`
 	MicrosoftRefreshToken string    `json:"microsoft_refresh_token"`
 	ExpiresAt             time.Time `json:"expires_at"`
+	MicrosoftExpiresAt    time.Time `json:"microsoft_expires_at,omitempty"`
 	ProfileID             string    `json:"profile_id"`
 	ProfileName           string    `json:"profile_name"`
 }
 
+// ErrLoginRequired is returned when no token can be obtained without the
+// user completing the device code flow.
+var ErrLoginRequired = errors.New("interactive login required")
+
+// ErrRefreshTokenRevoked is returned when Microsoft rejects the cached
+// refresh token, e.g. after a password change or revoked consent.
+var ErrRefreshTokenRevoked = fmt.Errorf("refresh token revoked: %w", ErrLoginRequired)
+
+// DefaultRefreshMargin is how long before the Minecraft token expires it
+// gets refreshed.
+const DefaultRefreshMargin = time.Hour
+
+// fallbackTokenLifetime is assumed when neither the login response nor the
+// token itself tells when the Minecraft token expires.
+const fallbackTokenLifetime = 6 * time.Hour
+
+func (t *TokenCache) Profile() Profile {
+	return Profile{Name: t.ProfileName, UUID: t.ProfileID, AccessToken: t.MinecraftAccessToken}
+}
+
+// ValidFor reports whether the Minecraft token is still valid d from now.
+func (t *TokenCache) ValidFor(d time.Duration) bool {
+	return t.MinecraftAccessToken != "" && time.Now().Add(d).Before(t.ExpiresAt)
+}
+
+const (
+	DeviceAuthPending   = "pending"
+	DeviceAuthCompleted = "completed"
+	DeviceAuthExpired   = "expired"
+	DeviceAuthDeclined  = "declined"
+	DeviceAuthCancelled = "cancelled"
+	DeviceAuthFailed    = "failed"
+)
+
+// DeviceAuthState describes a device code login, so it can be shown to
+// whoever has to enter the code.
+type DeviceAuthState struct {
+	Status                  string    `json:"status"`
+	UserCode                string    `json:"user_code,omitempty"`
+	VerificationURI         string    `json:"verification_uri,omitempty"`
+	VerificationURIComplete string    `json:"verification_uri_complete,omitempty"`
+	Message                 string    `json:"message,omitempty"`
+	StartedAt               time.Time `json:"started_at"`
+	ExpiresAt               time.Time `json:"expires_at,omitempty"`
+	LastPolledAt            time.Time `json:"last_polled_at,omitempty"`
+	Error                   string    `json:"error,omitempty"`
+}
+
 func StartDeviceAuth(clientID string) (string, string, error) {
-	deviceEndpoint := "https://login.microsoftonline.com/consumers/oauth2/v2.0/devicecode"
-	tokenEndpoint := "https://login.microsoftonline.com/consumers/oauth2/v2.0/token"
+	return DefaultClient.StartDeviceAuth(clientID)
+}
+
+func (c *Client) StartDeviceAuth(clientID string) (string, string, error) {
+	tr, err := c.deviceAuth(context.Background(), clientID, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	return tr.AccessToken, tr.RefreshToken, nil
+}
+
+// deviceAuth runs the device code flow until the user completes it, it
+// fails or ctx is done. observe, if not nil, is called whenever the state
+// of the flow changes.
+func (c *Client) deviceAuth(ctx context.Context, clientID string, observe func(DeviceAuthState)) (*TokenResponse, error) {
+	deviceEndpoint := c.url(c.Endpoints.MicrosoftLogin, "/devicecode")
+	tokenEndpoint := c.url(c.Endpoints.MicrosoftLogin, "/token")
+
+	state := DeviceAuthState{Status: DeviceAuthPending, StartedAt: time.Now()}
+	finish := func(status string, err error) error {
+		state.Status = status
+		if err != nil {
+			state.Error = err.Error()
+		}
+
+		if observe != nil {
+			observe(state)
+		}
+
+		return err
+	}
 
 	data := url.Values{}
 	data.Set("client_id", clientID)
 	data.Set("scope", "XboxLive.signin offline_access")
 
-	resp, err := http.Post(deviceEndpoint, "application/x-www-form-urlencoded", strings.NewReader(data.Encode()))
+	status, body, err := c.postForm(deviceEndpoint, data)
 	if err != nil {
-		return "", "", err
+		return nil, finish(DeviceAuthFailed, err)
 	}
 
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != 200 {
-		return "", "", fmt.Errorf("device code request failed: %s", string(body))
+	if status != http.StatusOK {
+		return nil, finish(DeviceAuthFailed, fmt.Errorf("device code request failed: %s", string(body)))
 	}
 
 	var dc DeviceCodeResponse
 	err = json.Unmarshal(body, &dc)
 	if err != nil {
-		return "", "", err
+		return nil, finish(DeviceAuthFailed, err)
 	}
 
 	log.Println("To authenticate with Microsoft, follow these steps:")
@@ -84,9 +157,16 @@ func StartDeviceAuth(clientID string) (string, string, error) {
 
 	expiresAt := time.Now().Add(time.Duration(dc.ExpiresIn) * time.Second)
 
+	state.UserCode = dc.UserCode
+	state.VerificationURI = dc.VerificationURI
+	state.VerificationURIComplete = dc.VerificationURIComplete
+	state.Message = dc.Message
+	state.ExpiresAt = expiresAt
+	_ = finish(DeviceAuthPending, nil)
+
 	for {
 		if time.Now().After(expiresAt) {
-			return "", "", errors.New("device code expired before verification")
+			return nil, finish(DeviceAuthExpired, errors.New("device code expired before verification"))
 		}
 
 		post := url.Values{}
@@ -94,125 +174,95 @@ func StartDeviceAuth(clientID string) (string, string, error) {
 		post.Set("client_id", clientID)
 		post.Set("device_code", dc.DeviceCode)
 
-		resp, err := http.Post(tokenEndpoint, "application/x-www-form-urlencoded", strings.NewReader(post.Encode()))
+		status, body, err := c.postForm(tokenEndpoint, post)
 		if err != nil {
-			return "", "", err
+			return nil, finish(DeviceAuthFailed, err)
 		}
 
-		body, _ := io.ReadAll(resp.Body)
-		_ = resp.Body.Close()
+		state.LastPolledAt = time.Now()
 
-		if resp.StatusCode == 200 {
+		if status == http.StatusOK {
 			var tr TokenResponse
 			err := json.Unmarshal(body, &tr)
 			if err != nil {
-				return "", "", err
+				return nil, finish(DeviceAuthFailed, err)
 			}
 
-			return tr.AccessToken, tr.RefreshToken, nil
+			return &tr, finish(DeviceAuthCompleted, nil)
 		}
 
 		var errObj map[string]any
 		_ = json.Unmarshal(body, &errObj)
 		errStr, ok := errObj["error"].(string)
 		if ok {
-			if errStr == "authorization_pending" {
-				time.Sleep(interval)
+			if errStr == "authorization_pending" || errStr == "slow_down" {
+				if errStr == "slow_down" {
+					interval += 5 * time.Second
+				}
+
+				_ = finish(DeviceAuthPending, nil)
+
+				timer := time.NewTimer(interval)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+
+					return nil, finish(DeviceAuthCancelled, context.Cause(ctx))
+				case <-timer.C:
+				}
+
 				continue
 			}
 
 			if errStr == "authorization_declined" {
-				return "", "", errors.New("authorization declined")
+				return nil, finish(DeviceAuthDeclined, errors.New("authorization declined"))
 			}
 
 			if errStr == "expired_token" {
-				return "", "", errors.New("device code expired")
+				return nil, finish(DeviceAuthExpired, errors.New("device code expired"))
 			}
 		}
 
-		return "", "", fmt.Errorf("token request failed: %s", string(body))
-	}
-}
-
-func loadTokenCache(path string) (*TokenCache, error) {
-	if path == "" {
-		return nil, errors.New("empty path")
-	}
-
-	b, err := os.ReadFile(path)
-	if err != nil {
-		return nil, err
-	}
-
-	var cache TokenCache
-	err = json.Unmarshal(b, &cache)
-
-	return &cache, err
-}
-
-func saveTokenCache(path string, cache *TokenCache) error {
-	if path == "" {
-		return nil
-	}
-
-	dir := filepathDir(path)
-	if dir != "" {
-		_ = os.MkdirAll(dir, 0700)
-	}
-
-	b, err := json.MarshalIndent(cache, "", "  ")
-	if err != nil {
-		return err
+		return nil, finish(DeviceAuthFailed, fmt.Errorf("token request failed: %s", string(body)))
 	}
-
-	return os.WriteFile(path, b, 0600)
 }
 
-func filepathDir(path string) string {
-	for i := len(path) - 1; i >= 0; i-- {
-		if path[i] == '/' {
-			if i == 0 {
-				return "/"
-			}
-
-			return path[:i]
-		}
-	}
-
-	return ""
-}
-
-func refreshMicrosoftToken(clientID, refreshToken string) (string, string, error) {
-	tokenEndpoint := "https://login.microsoftonline.com/consumers/oauth2/v2.0/token"
-
+func (c *Client) refreshMicrosoftToken(clientID, refreshToken string) (*TokenResponse, error) {
 	post := url.Values{}
 	post.Set("grant_type", "refresh_token")
 	post.Set("client_id", clientID)
 	post.Set("refresh_token", refreshToken)
 	post.Set("scope", "XboxLive.signin offline_access")
 
-	resp, err := http.Post(tokenEndpoint, "application/x-www-form-urlencoded", strings.NewReader(post.Encode()))
+	status, body, err := c.postForm(c.url(c.Endpoints.MicrosoftLogin, "/token"), post)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != 200 {
-		return "", "", fmt.Errorf("refresh token request failed: %s", string(body))
+	if status != http.StatusOK {
+		var errObj struct {
+			Error string `json:"error"`
+		}
+
+		_ = json.Unmarshal(body, &errObj)
+		if errObj.Error == "invalid_grant" {
+			return nil, ErrRefreshTokenRevoked
+		}
+
+		return nil, fmt.Errorf("refresh token request failed: %s", string(body))
 	}
 
 	var tr TokenResponse
 	err = json.Unmarshal(body, &tr)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
 
-	return tr.AccessToken, tr.RefreshToken, nil
+	return &tr, nil
 }
 
-func xboxAuthenticate(msAccessToken string) (string, string, error) {
-	requestBody, _ := json.Marshal(map[string]any{
+func (c *Client) xboxAuthenticate(msAccessToken string) (string, string, error) {
+	status, body, err := c.postJSON(c.url(c.Endpoints.XboxUser, "/user/authenticate"), map[string]any{
 		"Properties": map[string]string{
 			"AuthMethod": "RPS",
 			"SiteName":   "user.auth.xboxlive.com",
@@ -221,16 +271,11 @@ func xboxAuthenticate(msAccessToken string) (string, string, error) {
 		"RelyingParty": "http://auth.xboxlive.com",
 		"TokenType":    "JWT",
 	})
-
-	resp, err := http.Post("https://user.auth.xboxlive.com/user/authenticate", "application/json", bytes.NewReader(requestBody))
 	if err != nil {
 		return "", "", err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
-
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != 200 {
+	if status != http.StatusOK {
 		return "", "", fmt.Errorf("xbox auth failed: %s", string(body))
 	}
 
@@ -262,8 +307,44 @@ func xboxAuthenticate(msAccessToken string) (string, string, error) {
 	return token, "", nil
 }
 
-func xstsAuthorize(xblToken string) (string, string, error) {
-	reqBody, _ := json.Marshal(map[string]any{
+// XboxError is XSTS refusing an account, e.g. one without an Xbox profile
+// or a child account not added to a family.
+type XboxError struct {
+	XErr     int64  `json:"XErr"`
+	Message  string `json:"Message"`
+	Redirect string `json:"Redirect"`
+}
+
+// xboxErrors explains the XErr codes that need the account owner to act.
+var xboxErrors = map[int64]string{
+	2148916227: "account is banned from Xbox",
+	2148916229: "account needs parental consent to play online",
+	2148916233: "account has no Xbox profile, sign in at minecraft.net first",
+	2148916234: "account has not accepted the Xbox terms of use",
+	2148916235: "Xbox Live is not available in the account's country",
+	2148916236: "account needs adult verification",
+	2148916237: "account needs adult verification",
+	2148916238: "account belongs to a child and must be added to a family",
+}
+
+func (e *XboxError) Error() string {
+	reason, ok := xboxErrors[e.XErr]
+	if !ok {
+		reason = "xsts auth failed"
+		if e.Message != "" {
+			reason += ": " + e.Message
+		}
+	}
+
+	if e.Redirect != "" {
+		return fmt.Sprintf("%s (XErr %d, see %s)", reason, e.XErr, e.Redirect)
+	}
+
+	return fmt.Sprintf("%s (XErr %d)", reason, e.XErr)
+}
+
+func (c *Client) xstsAuthorize(xblToken string) (string, string, error) {
+	status, body, err := c.postJSON(c.url(c.Endpoints.XboxXSTS, "/xsts/authorize"), map[string]any{
 		"Properties": map[string]any{
 			"SandboxId":  "RETAIL",
 			"UserTokens": []string{xblToken},
@@ -271,16 +352,16 @@ func xstsAuthorize(xblToken string) (string, string, error) {
 		"RelyingParty": "rp://api.minecraftservices.com/",
 		"TokenType":    "JWT",
 	})
-
-	resp, err := http.Post("https://xsts.auth.xboxlive.com/xsts/authorize", "application/json", bytes.NewReader(reqBody))
 	if err != nil {
 		return "", "", err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
+	if status != http.StatusOK {
+		var xerr XboxError
+		if json.Unmarshal(body, &xerr) == nil && xerr.XErr != 0 {
+			return "", "", &xerr
+		}
 
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != 200 {
 		return "", "", fmt.Errorf("xsts auth failed: %s", string(body))
 	}
 
@@ -313,53 +394,42 @@ func xstsAuthorize(xblToken string) (string, string, error) {
 	return token, "", nil
 }
 
-func minecraftLogin(xstsToken string, uhs string) (string, error) {
-	requestBody, _ := json.Marshal(map[string]string{
+func (c *Client) minecraftLogin(xstsToken string, uhs string) (string, time.Time, error) {
+	status, body, err := c.postJSON(c.url(c.Endpoints.MinecraftServices, "/authentication/login_with_xbox"), map[string]string{
 		"identityToken": "XBL3.0 x=" + uhs + ";" + xstsToken,
 	})
-
-	resp, err := http.Post("https://api.minecraftservices.com/authentication/login_with_xbox", "application/json", bytes.NewReader(requestBody))
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
+	if status != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("minecraft login failed: %s", string(body))
+	}
 
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("minecraft login failed: %s", string(body))
+	var responseObject struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
 	}
 
-	var responseObject map[string]any
 	err = json.Unmarshal(body, &responseObject)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 
-	token, ok := responseObject["access_token"].(string)
-	if ok {
-		return token, nil
+	if responseObject.AccessToken == "" {
+		return "", time.Time{}, errors.New("no access_token in minecraft login response")
 	}
 
-	return "", errors.New("no access_token in minecraft login response")
+	return responseObject.AccessToken, tokenExpiry(responseObject.AccessToken, responseObject.ExpiresIn), nil
 }
 
-func checkEntitlements(mcToken string) (bool, error) {
-	req, err := http.NewRequest(http.MethodGet, "https://api.minecraftservices.com/entitlements/mcstore", nil)
-	if err != nil {
-		return false, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+mcToken)
-	resp, err := http.DefaultClient.Do(req)
+func (c *Client) checkEntitlements(mcToken string) (bool, error) {
+	status, body, err := c.getWithToken(c.url(c.Endpoints.MinecraftServices, "/entitlements/mcstore"), mcToken)
 	if err != nil {
 		return false, err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
-
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != 200 {
+	if status != http.StatusOK {
 		return false, fmt.Errorf("entitlements check failed: %s", string(body))
 	}
 
@@ -377,26 +447,17 @@ func checkEntitlements(mcToken string) (bool, error) {
 	return false, nil
 }
 
-func fetchMinecraftProfile(mcToken string) (string, string, error) {
+func (c *Client) fetchMinecraftProfile(mcToken string) (string, string, error) {
 	if mcToken == "" {
 		return "", "", errors.New("empty minecraft token")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, "https://api.minecraftservices.com/minecraft/profile", nil)
+	status, body, err := c.getWithToken(c.url(c.Endpoints.MinecraftServices, "/minecraft/profile"), mcToken)
 	if err != nil {
 		return "", "", err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+mcToken)
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return "", "", err
-	}
-
-	defer func() { _ = resp.Body.Close() }()
-
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != 200 {
+	if status != http.StatusOK {
 		return "", "", fmt.Errorf("failed to fetch profile: %s", string(body))
 	}
 
@@ -416,63 +477,168 @@ func fetchMinecraftProfile(mcToken string) (string, string, error) {
 }
 
 func GetMinecraftToken(clientID, tokenFile string) (mcToken, profileID, profileName string, err error) {
-	if tokenFile != "" {
-		cache, err := loadTokenCache(tokenFile)
-		if err == nil && cache != nil {
-			log.Println("Loaded token cache from file")
+	return DefaultClient.GetMinecraftToken(clientID, tokenFile)
+}
 
-			if cache.MinecraftAccessToken != "" && time.Now().Before(cache.ExpiresAt) {
-				log.Println("Cached Minecraft token is still valid")
+// GetMinecraftToken returns a usable Minecraft token, falling back to the
+// interactive device code flow when the cached one can't be used.
+func (c *Client) GetMinecraftToken(clientID, tokenFile string) (mcToken, profileID, profileName string, err error) {
+	cache, err := c.LoadToken(clientID, tokenFile, DefaultRefreshMargin)
+	if errors.Is(err, ErrTokenCacheUnreadable) {
+		return "", "", "", err
+	}
 
-				id, name, err := fetchMinecraftProfile(cache.MinecraftAccessToken)
-				if err == nil && id != "" && name != "" {
-					log.Println("Using cached Minecraft access token")
+	if err != nil {
+		log.Printf("Cached token unusable: %v, will re-authenticate", err)
 
-					return cache.MinecraftAccessToken, id, name, nil
-				}
+		cache, err = c.DeviceLogin(context.Background(), clientID, tokenFile, nil)
+		if err != nil {
+			return "", "", "", err
+		}
+	}
 
-				log.Println("Cached Minecraft token validation failed")
-			}
+	return cache.MinecraftAccessToken, cache.ProfileID, cache.ProfileName, nil
+}
 
-			if cache.MicrosoftRefreshToken != "" {
-				log.Println("Minecraft token expired, attempting refresh using Microsoft refresh token...")
-				msToken, newRefreshToken, err := refreshMicrosoftToken(clientID, cache.MicrosoftRefreshToken)
-				if err == nil {
-					log.Println("Successfully refreshed Microsoft access token")
+// LoadToken returns the cached token if it stays valid for at least margin,
+// otherwise it refreshes it with the cached Microsoft refresh token. It never
+// starts the device code flow and returns ErrLoginRequired instead, or
+// ErrTokenCacheUnreadable for a cache it can't decrypt or parse.
+//
+// The token file stays locked until the refreshed token is written back, so
+// processes sharing the file don't redeem the same refresh token twice.
+func (c *Client) LoadToken(clientID, tokenFile string, margin time.Duration) (*TokenCache, error) {
+	unlock, err := lockTokenFile(tokenFile)
+	if err != nil {
+		return nil, err
+	}
 
-					return completeMicrosoftAuth(msToken, newRefreshToken, tokenFile)
-				}
+	defer unlock()
 
-				log.Printf("Failed to refresh token: %v, will re-authenticate", err)
-			}
-		} else {
-			log.Println("No valid token cache found, will authenticate")
+	cache, err := c.loadTokenCache(tokenFile)
+	if errors.Is(err, ErrTokenCacheUnreadable) {
+		return nil, err
+	}
+
+	if err != nil {
+		log.Println("No valid token cache found, will authenticate")
+
+		return nil, fmt.Errorf("%w: %v", ErrLoginRequired, err)
+	}
+
+	log.Println("Loaded token cache from file")
+
+	if cache.ValidFor(margin) {
+		log.Println("Cached Minecraft token is still valid")
+
+		id, name, err := c.fetchMinecraftProfile(cache.MinecraftAccessToken)
+		if err == nil && id != "" && name != "" {
+			log.Println("Using cached Minecraft access token")
+
+			cache.ProfileID, cache.ProfileName = id, name
+
+			return cache, nil
 		}
+
+		log.Println("Cached Minecraft token validation failed")
 	}
 
+	if cache.MicrosoftRefreshToken == "" {
+		return nil, ErrLoginRequired
+	}
+
+	log.Println("Minecraft token expired, attempting refresh using Microsoft refresh token...")
+
+	return c.refreshToken(clientID, cache.MicrosoftRefreshToken, tokenFile)
+}
+
+// RefreshToken trades a Microsoft refresh token for a new Minecraft token
+// and stores the result in tokenFile.
+func (c *Client) RefreshToken(clientID, refreshToken, tokenFile string) (*TokenCache, error) {
+	unlock, err := lockTokenFile(tokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	defer unlock()
+
+	return c.refreshToken(clientID, refreshToken, tokenFile)
+}
+
+func (c *Client) refreshToken(clientID, refreshToken, tokenFile string) (*TokenCache, error) {
+	tr, err := c.refreshMicrosoftToken(clientID, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("Successfully refreshed Microsoft access token")
+
+	cache, err := c.completeMicrosoftAuth(tr, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	c.storeTokenCache(tokenFile, cache)
+
+	return cache, nil
+}
+
+// DeviceLogin runs the interactive device code flow and stores the result
+// in tokenFile. The flow is abandoned when ctx is done; observe, if not
+// nil, receives its progress.
+func (c *Client) DeviceLogin(ctx context.Context, clientID, tokenFile string, observe func(DeviceAuthState)) (*TokenCache, error) {
 	log.Println("Starting Microsoft device auth...")
 
-	msToken, msRefreshToken, err := StartDeviceAuth(clientID)
+	tr, err := c.deviceAuth(ctx, clientID, observe)
 	if err != nil {
-		return "", "", "", err
+		return nil, err
+	}
+
+	cache, err := c.completeMicrosoftAuth(tr, "")
+	if err != nil {
+		return nil, err
 	}
 
-	return completeMicrosoftAuth(msToken, msRefreshToken, tokenFile)
+	unlock, err := lockTokenFile(tokenFile)
+	if err != nil {
+		log.Printf("warning: failed to lock token cache: %v", err)
+
+		return cache, nil
+	}
+
+	defer unlock()
+
+	c.storeTokenCache(tokenFile, cache)
+
+	return cache, nil
+}
+
+func (c *Client) storeTokenCache(tokenFile string, cache *TokenCache) {
+	if tokenFile == "" {
+		return
+	}
+
+	err := c.saveTokenCache(tokenFile, cache)
+	if err != nil {
+		log.Printf("warning: failed to save token cache to file: %v", err)
+	} else {
+		log.Println("Saved token cache to file")
+	}
 }
 
-func completeMicrosoftAuth(msToken, msRefreshToken, tokenFile string) (mcToken, profileID, profileName string, err error) {
+func (c *Client) completeMicrosoftAuth(tr *TokenResponse, oldRefreshToken string) (*TokenCache, error) {
 	log.Println("Microsoft access token obtained, exchanging for Xbox Live token...")
 
-	xblToken, uhs, err := xboxAuthenticate(msToken)
+	xblToken, uhs, err := c.xboxAuthenticate(tr.AccessToken)
 	if err != nil {
-		return "", "", "", fmt.Errorf("xbox authenticate failed: %w", err)
+		return nil, fmt.Errorf("xbox authenticate failed: %w", err)
 	}
 
 	log.Println("Xbox Live token obtained, exchanging for XSTS token...")
 
-	xstsToken, uhs2, err := xstsAuthorize(xblToken)
+	xstsToken, uhs2, err := c.xstsAuthorize(xblToken)
 	if err != nil {
-		return "", "", "", fmt.Errorf("xsts authorize failed: %w", err)
+		return nil, fmt.Errorf("xsts authorize failed: %w", err)
 	}
 	if uhs == "" && uhs2 != "" {
 		uhs = uhs2
@@ -480,42 +646,83 @@ func completeMicrosoftAuth(msToken, msRefreshToken, tokenFile string) (mcToken,
 
 	log.Println("XSTS token obtained, logging into Minecraft services...")
 
-	mcToken, err = minecraftLogin(xstsToken, uhs)
+	mcToken, expiresAt, err := c.minecraftLogin(xstsToken, uhs)
 	if err != nil {
-		return "", "", "", fmt.Errorf("minecraft login failed: %w", err)
+		return nil, fmt.Errorf("minecraft login failed: %w", err)
 	}
 
-	owns, err := checkEntitlements(mcToken)
+	owns, err := c.checkEntitlements(mcToken)
 	if err != nil {
 		log.Printf("warning: failed to check entitlements: %v", err)
 	} else if !owns {
 		log.Println("warning: account does not appear to own Minecraft (entitlements empty)")
 	}
 
-	log.Println("Minecraft access token obtained")
+	log.Printf("Minecraft access token obtained, expires at %s", expiresAt.Format(time.RFC3339))
 
-	profileID, profileName, err = fetchMinecraftProfile(mcToken)
+	profileID, profileName, err := c.fetchMinecraftProfile(mcToken)
 	if err != nil {
-		return "", "", "", fmt.Errorf("failed to fetch Minecraft profile: %w", err)
+		return nil, fmt.Errorf("failed to fetch Minecraft profile: %w", err)
 	}
 
 	log.Printf("Retrieved Minecraft profile - Name: %q (len=%d), ID: %s", profileName, len(profileName), profileID)
 
-	if tokenFile != "" {
-		cache := &TokenCache{
-			MinecraftAccessToken:  mcToken,
-			MicrosoftRefreshToken: msRefreshToken,
-			ExpiresAt:             time.Now().Add(6 * time.Hour),
-			ProfileID:             profileID,
-			ProfileName:           profileName,
-		}
-		err = saveTokenCache(tokenFile, cache)
-		if err != nil {
-			log.Printf("warning: failed to save token cache to file: %v", err)
-		} else {
-			log.Println("Saved token cache to file")
-		}
+	// Microsoft doesn't always rotate the refresh token.
+	refreshToken := tr.RefreshToken
+	if refreshToken == "" {
+		refreshToken = oldRefreshToken
+	}
+
+	cache := &TokenCache{
+		MinecraftAccessToken:  mcToken,
+		MicrosoftRefreshToken: refreshToken,
+		ExpiresAt:             expiresAt,
+		MicrosoftExpiresAt:    time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second),
+		ProfileID:             profileID,
+		ProfileName:           profileName,
+	}
+
+	return cache, nil
+}
+
+// tokenExpiry picks the earlier of the JWT exp claim in token and
+// expiresIn seconds from now, whichever of them is known.
+func tokenExpiry(token string, expiresIn int) time.Time {
+	var expiresAt time.Time
+	if expiresIn > 0 {
+		expiresAt = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+
+	if exp, ok := jwtExpiry(token); ok && (expiresAt.IsZero() || exp.Before(expiresAt)) {
+		expiresAt = exp
+	}
+
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(fallbackTokenLifetime)
+	}
+
+	return expiresAt
+}
+
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+
+	err = json.Unmarshal(payload, &claims)
+	if err != nil || claims.Exp == 0 {
+		return time.Time{}, false
 	}
 
-	return mcToken, profileID, profileName, nil
+	return time.Unix(claims.Exp, 0), true
 }
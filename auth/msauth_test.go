@@ -0,0 +1,346 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testClientID = "client-id"
+	testUUID     = "069a79f444e94726a5befca90e38aaf5"
+	testName     = "Notch"
+)
+
+// fakeServices stands in for every service of the login chain.
+type fakeServices struct {
+	t *testing.T
+
+	mu sync.Mutex
+	// pending is how many polls of the token endpoint are still pending.
+	pending int
+	// refreshErr, if set, is the OAuth error of a refresh token request.
+	refreshErr string
+	// xerr, if set, makes XSTS refuse the account.
+	xerr  int64
+	calls map[string]int
+}
+
+func newFakeServices(t *testing.T) (*fakeServices, *Client) {
+	f := &fakeServices{t: t, calls: make(map[string]int)}
+
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+
+	client := NewClient(Endpoints{
+		MicrosoftLogin:    srv.URL + "/ms",
+		XboxUser:          srv.URL + "/xbl",
+		XboxXSTS:          srv.URL + "/xsts",
+		MinecraftServices: srv.URL + "/mc",
+		SessionServer:     srv.URL + "/session",
+	})
+	client.HTTPClient = srv.Client()
+
+	return f, client
+}
+
+func (f *fakeServices) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /ms/devicecode", func(w http.ResponseWriter, r *http.Request) {
+		f.count("devicecode")
+		writeJSON(w, http.StatusOK, DeviceCodeResponse{
+			DeviceCode:      "device-code",
+			UserCode:        "ABCD-EFGH",
+			VerificationURI: "https://example.com/link",
+			ExpiresIn:       60,
+			Interval:        1,
+		})
+	})
+
+	mux.HandleFunc("POST /ms/token", func(w http.ResponseWriter, r *http.Request) {
+		f.count("token")
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		switch r.PostFormValue("grant_type") {
+		case "refresh_token":
+			if r.PostFormValue("refresh_token") != "refresh-token" {
+				f.t.Errorf("refresh token = %q", r.PostFormValue("refresh_token"))
+			}
+
+			if f.refreshErr != "" {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": f.refreshErr})
+
+				return
+			}
+		default:
+			if r.PostFormValue("device_code") != "device-code" {
+				f.t.Errorf("device code = %q", r.PostFormValue("device_code"))
+			}
+
+			if f.pending > 0 {
+				f.pending--
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "authorization_pending"})
+
+				return
+			}
+		}
+
+		writeJSON(w, http.StatusOK, TokenResponse{
+			AccessToken:  "ms-token",
+			RefreshToken: "refresh-token",
+			ExpiresIn:    3600,
+		})
+	})
+
+	mux.HandleFunc("POST /xbl/user/authenticate", func(w http.ResponseWriter, r *http.Request) {
+		f.count("xbl")
+
+		var body struct{ Properties struct{ RpsTicket string } }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Properties.RpsTicket != "d=ms-token" {
+			f.t.Errorf("RpsTicket = %q", body.Properties.RpsTicket)
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"Token":         "xbl-token",
+			"DisplayClaims": map[string]any{"xui": []any{map[string]any{"uhs": "user-hash"}}},
+		})
+	})
+
+	mux.HandleFunc("POST /xsts/xsts/authorize", func(w http.ResponseWriter, r *http.Request) {
+		f.count("xsts")
+
+		f.mu.Lock()
+		xerr := f.xerr
+		f.mu.Unlock()
+
+		if xerr != 0 {
+			writeJSON(w, http.StatusUnauthorized, map[string]any{
+				"Identity": "0",
+				"XErr":     xerr,
+				"Message":  "",
+				"Redirect": "https://start.ui.xboxlive.com/AddChildToFamily",
+			})
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"Token":         "xsts-token",
+			"DisplayClaims": map[string]any{"xui": []any{map[string]any{"uhs": "user-hash"}}},
+		})
+	})
+
+	mux.HandleFunc("POST /mc/authentication/login_with_xbox", func(w http.ResponseWriter, r *http.Request) {
+		f.count("login_with_xbox")
+
+		var body struct{ IdentityToken string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.IdentityToken != "XBL3.0 x=user-hash;xsts-token" {
+			f.t.Errorf("identityToken = %q", body.IdentityToken)
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"access_token": testJWT(time.Now().Add(24 * time.Hour)), "expires_in": 86400})
+	})
+
+	mux.HandleFunc("GET /mc/entitlements/mcstore", func(w http.ResponseWriter, r *http.Request) {
+		f.count("entitlements")
+		writeJSON(w, http.StatusOK, map[string]any{"items": []any{map[string]string{"name": "game_minecraft"}}})
+	})
+
+	mux.HandleFunc("GET /mc/minecraft/profile", func(w http.ResponseWriter, r *http.Request) {
+		f.count("profile")
+		writeJSON(w, http.StatusOK, map[string]string{"id": testUUID, "name": testName})
+	})
+
+	return mux
+}
+
+func (f *fakeServices) count(call string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls[call]++
+}
+
+func (f *fakeServices) called(call string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls[call]
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// testJWT returns an unsigned JWT expiring at exp.
+func testJWT(exp time.Time) string {
+	enc := base64.RawURLEncoding.EncodeToString
+
+	return enc([]byte(`{"alg":"none"}`)) + "." + enc(fmt.Appendf(nil, `{"exp":%d}`, exp.Unix())) + ".sig"
+}
+
+func TestDeviceLogin(t *testing.T) {
+	f, client := newFakeServices(t)
+	f.pending = 1
+
+	tokenFile := filepath.Join(t.TempDir(), "token.mctoken")
+
+	var states []string
+	cache, err := client.DeviceLogin(context.Background(), testClientID, tokenFile, func(s DeviceAuthState) {
+		states = append(states, s.Status)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cache.ProfileID != testUUID || cache.ProfileName != testName {
+		t.Errorf("profile = %s %s", cache.ProfileID, cache.ProfileName)
+	}
+
+	if cache.MicrosoftRefreshToken != "refresh-token" {
+		t.Errorf("refresh token = %q", cache.MicrosoftRefreshToken)
+	}
+
+	if !cache.ValidFor(time.Hour) {
+		t.Errorf("token expires at %v", cache.ExpiresAt)
+	}
+
+	if got := f.called("token"); got != 2 {
+		t.Errorf("token endpoint polled %d times, want 2", got)
+	}
+
+	if states[len(states)-1] != DeviceAuthCompleted {
+		t.Errorf("device auth states = %v", states)
+	}
+
+	stored, err := client.loadTokenCache(tokenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stored.MinecraftAccessToken != cache.MinecraftAccessToken {
+		t.Error("token cache not stored")
+	}
+}
+
+func TestXboxErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		xerr int64
+	}{
+		{"no Xbox profile", 2148916233},
+		{"adult verification", 2148916236},
+		{"child account", 2148916238},
+		{"unknown", 2148916999},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, client := newFakeServices(t)
+			f.xerr = tt.xerr
+
+			_, err := client.DeviceLogin(context.Background(), testClientID, "", nil)
+
+			var xerr *XboxError
+			if !errors.As(err, &xerr) {
+				t.Fatalf("err = %v, want an XboxError", err)
+			}
+
+			if xerr.XErr != tt.xerr || xerr.Redirect == "" {
+				t.Errorf("XboxError = %+v", xerr)
+			}
+
+			if f.called("login_with_xbox") != 0 {
+				t.Error("logged in to Minecraft after XSTS refused the account")
+			}
+		})
+	}
+}
+
+func TestLoadToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		cache      TokenCache
+		refreshErr string
+		wantErr    error
+		wantToken  bool
+	}{
+		{
+			name:  "valid",
+			cache: TokenCache{MinecraftAccessToken: "cached", ExpiresAt: time.Now().Add(24 * time.Hour), MicrosoftRefreshToken: "refresh-token"},
+		},
+		{
+			name:      "expired",
+			cache:     TokenCache{MinecraftAccessToken: "cached", ExpiresAt: time.Now().Add(-time.Hour), MicrosoftRefreshToken: "refresh-token"},
+			wantToken: true,
+		},
+		{
+			name:       "expired refresh token",
+			cache:      TokenCache{MinecraftAccessToken: "cached", ExpiresAt: time.Now().Add(-time.Hour), MicrosoftRefreshToken: "refresh-token"},
+			refreshErr: "invalid_grant",
+			wantErr:    ErrRefreshTokenRevoked,
+		},
+		{
+			name:    "no refresh token",
+			cache:   TokenCache{MinecraftAccessToken: "cached", ExpiresAt: time.Now().Add(-time.Hour)},
+			wantErr: ErrLoginRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, client := newFakeServices(t)
+			f.refreshErr = tt.refreshErr
+
+			tokenFile := filepath.Join(t.TempDir(), "token.mctoken")
+			if err := client.saveTokenCache(tokenFile, &tt.cache); err != nil {
+				t.Fatal(err)
+			}
+
+			cache, err := client.LoadToken(testClientID, tokenFile, DefaultRefreshMargin)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) || !errors.Is(err, ErrLoginRequired) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			refreshed := cache.MinecraftAccessToken != "cached"
+			if refreshed != tt.wantToken {
+				t.Errorf("refreshed = %v, want %v", refreshed, tt.wantToken)
+			}
+
+			if got := f.called("token"); got != btoi(tt.wantToken) {
+				t.Errorf("token endpoint called %d times", got)
+			}
+		})
+	}
+}
+
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
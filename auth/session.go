@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type sessionProfile struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type joinRequest struct {
+	AccessToken     string         `json:"accessToken"`
+	SelectedProfile sessionProfile `json:"selectedProfile"`
+	ServerID        string         `json:"serverId"`
+}
+
+// JoinSession tells the session server that profile is joining the server
+// identified by serverHash, as required before answering an online mode
+// encryption request.
+func (c *Client) JoinSession(profile Profile, serverHash string) error {
+	status, body, err := c.postJSON(c.url(c.Endpoints.SessionServer, "/session/minecraft/join"), joinRequest{
+		AccessToken: profile.AccessToken,
+		SelectedProfile: sessionProfile{
+			ID:   profile.UUID,
+			Name: profile.Name,
+		},
+		ServerID: serverHash,
+	})
+	if err != nil {
+		return fmt.Errorf("post fail: %v", err)
+	}
+
+	if status != http.StatusNoContent {
+		return fmt.Errorf("msauth fail: %s", string(body))
+	}
+
+	return nil
+}
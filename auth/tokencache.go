@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const tokenCacheAlgorithm = "AES-GCM"
+
+// encryptedTokenCache is the on-disk form of a TokenCache written with a
+// CacheKey. Plain caches are still read so existing files keep working and
+// get encrypted on the next save.
+type encryptedTokenCache struct {
+	Algorithm  string `json:"algorithm"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// ErrTokenCacheUnreadable is returned for a token cache that exists but
+// can't be read, e.g. because it is encrypted with another key. Logging in
+// again would overwrite it, so it is left to the user to fix.
+var ErrTokenCacheUnreadable = errors.New("token cache unreadable")
+
+// LoadTokenKey returns the token cache key given either directly as value or
+// in the file at path. Keys are 16, 24 or 32 bytes written as base64. A key
+// file that isn't base64 is taken as the raw key. It returns nil when neither
+// is set.
+func LoadTokenKey(value, path string) ([]byte, error) {
+	if value != "" {
+		return parseTokenKey([]byte(value))
+	}
+
+	if path == "" {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read token key: %w", err)
+	}
+
+	if _, err := decodeTokenKey(b); err != nil && isAESKeySize(len(b)) {
+		return b, nil
+	}
+
+	return parseTokenKey(b)
+}
+
+func parseTokenKey(b []byte) ([]byte, error) {
+	key, err := decodeTokenKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("token key is not valid base64: %w", err)
+	}
+
+	if !isAESKeySize(len(key)) {
+		return nil, fmt.Errorf("token key must be 16, 24 or 32 bytes, got %d", len(key))
+	}
+
+	return key, nil
+}
+
+func decodeTokenKey(b []byte) ([]byte, error) {
+	s := strings.TrimSpace(string(b))
+
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		key, err = base64.RawStdEncoding.DecodeString(s)
+	}
+
+	return key, err
+}
+
+func isAESKeySize(n int) bool {
+	return n == 16 || n == 24 || n == 32
+}
+
+func (c *Client) loadTokenCache(path string) (*TokenCache, error) {
+	if path == "" {
+		return nil, errors.New("empty path")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var envelope encryptedTokenCache
+	err = json.Unmarshal(b, &envelope)
+	if err == nil && envelope.Ciphertext != nil {
+		b, err = c.decryptTokenCache(envelope)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrTokenCacheUnreadable, err)
+		}
+	}
+
+	var cache TokenCache
+	err = json.Unmarshal(b, &cache)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTokenCacheUnreadable, err)
+	}
+
+	return &cache, nil
+}
+
+// saveTokenCache writes cache next to path and renames it into place, so a
+// crash never leaves a truncated cache behind.
+func (c *Client) saveTokenCache(path string, cache *TokenCache) error {
+	if path == "" {
+		return nil
+	}
+
+	dir := filepath.Dir(path)
+	_ = os.MkdirAll(dir, 0700)
+
+	b, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if c.CacheKey != nil {
+		envelope, err := c.encryptTokenCache(b)
+		if err != nil {
+			return err
+		}
+
+		b, err = json.MarshalIndent(envelope, "", "  ")
+		if err != nil {
+			return err
+		}
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	_, err = tmp.Write(b)
+	if err == nil {
+		err = tmp.Chmod(0600)
+	}
+
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (c *Client) tokenCacheAEAD() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(c.CacheKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (c *Client) encryptTokenCache(plaintext []byte) (*encryptedTokenCache, error) {
+	aead, err := c.tokenCacheAEAD()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return &encryptedTokenCache{
+		Algorithm:  tokenCacheAlgorithm,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, []byte(tokenCacheAlgorithm)),
+	}, nil
+}
+
+func (c *Client) decryptTokenCache(envelope encryptedTokenCache) ([]byte, error) {
+	if c.CacheKey == nil {
+		return nil, errors.New("token cache is encrypted but no key is configured")
+	}
+
+	if envelope.Algorithm != tokenCacheAlgorithm {
+		return nil, fmt.Errorf("unsupported token cache algorithm %q", envelope.Algorithm)
+	}
+
+	aead, err := c.tokenCacheAEAD()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(envelope.Nonce) != aead.NonceSize() {
+		return nil, errors.New("token cache has an invalid nonce")
+	}
+
+	plaintext, err := aead.Open(nil, envelope.Nonce, envelope.Ciphertext, []byte(envelope.Algorithm))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt token cache: %w", err)
+	}
+
+	return plaintext, nil
+}
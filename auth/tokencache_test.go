@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadTokenKey(t *testing.T) {
+	raw := func(n int) []byte { return bytes.Repeat([]byte{0xfe}, n) }
+	encoded := func(n int) []byte { return []byte(base64.StdEncoding.EncodeToString(raw(n)) + "\n") }
+
+	tests := []struct {
+		name string
+		file []byte
+		want []byte
+	}{
+		// The base64 form of 16 and 24 byte keys is 24 and 32 characters
+		// long, as long as raw keys.
+		{"base64 16 bytes", encoded(16), raw(16)},
+		{"base64 24 bytes", encoded(24), raw(24)},
+		{"base64 32 bytes", encoded(32), raw(32)},
+		{"raw 32 bytes", raw(32), raw(32)},
+		{"raw 16 bytes", raw(16), raw(16)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "key")
+			if err := os.WriteFile(path, tt.file, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			key, err := LoadTokenKey("", path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(key, tt.want) {
+				t.Errorf("key = %x, want %x", key, tt.want)
+			}
+
+			if tt.file[0] != 0xfe {
+				env, err := LoadTokenKey(string(tt.file), "")
+				if err != nil || !bytes.Equal(env, key) {
+					t.Errorf("key from the variable = %x, %v", env, err)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenCacheEncryption(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.mctoken")
+	cache := &TokenCache{MinecraftAccessToken: "token", MicrosoftRefreshToken: "refresh", ExpiresAt: time.Now().Add(time.Hour).Round(0)}
+
+	c := &Client{CacheKey: bytes.Repeat([]byte{1}, 32)}
+	if err := c.saveTokenCache(path, cache); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Contains(b, []byte("refresh")) {
+		t.Error("refresh token written in plain text")
+	}
+
+	got, err := c.loadTokenCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.MicrosoftRefreshToken != cache.MicrosoftRefreshToken || !got.ExpiresAt.Equal(cache.ExpiresAt) {
+		t.Errorf("loaded %+v", got)
+	}
+
+	for name, key := range map[string][]byte{"wrong key": bytes.Repeat([]byte{2}, 32), "no key": nil} {
+		other := &Client{CacheKey: key}
+
+		_, err := other.LoadToken(testClientID, path, DefaultRefreshMargin)
+		if !errors.Is(err, ErrTokenCacheUnreadable) || errors.Is(err, ErrLoginRequired) {
+			t.Errorf("%s: err = %v, want ErrTokenCacheUnreadable", name, err)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package basic
 
 import (
+	"github.com/google/uuid"
+
 	"mcAfkGo/bot"
 	"mcAfkGo/data/packetid"
 	pk "mcAfkGo/net/packet"
@@ -12,12 +14,20 @@ type Player struct {
 
 	PlayerInfo
 	WorldInfo
+
+	lastDamage damage
+	// dead is set from the death until the player respawned.
+	dead         bool
+	deathHandler func(d Death) error
+
+	// players are the names of the players on the server by UUID.
+	players map[uuid.UUID]string
 }
 
-func NewPlayer(c *bot.Client, settings Settings, events EventsListener) *Player {
-	p := &Player{c: c, Settings: settings}
+func NewPlayer(c *bot.Client, settings Settings, events EventsListener) (*Player, error) {
+	p := &Player{c: c, Settings: settings, players: make(map[uuid.UUID]string)}
 
-	c.Events.AddListener(
+	_, err := c.Events.AddListener(
 		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundLogin, F: p.handleLoginPacket},
 		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundKeepAlive, F: p.handleKeepAlivePacket},
 		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundRespawn, F: p.handleRespawnPacket},
@@ -25,11 +35,26 @@ func NewPlayer(c *bot.Client, settings Settings, events EventsListener) *Player
 		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundCookieRequest, F: p.handleCookieRequestPacket},
 		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundStoreCookie, F: p.handleStoreCookiePacket},
 		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundUpdateTags, F: p.handleUpdateTags},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundPlayerPosition, F: p.handlePlayerPositionPacket},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundDamageEvent, F: p.handleDamageEventPacket},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundSetHealth, F: p.handleSetHealthPacket},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundPlayerCombatKill, F: p.handlePlayerCombatKillPacket},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundSystemChat, F: p.handleSystemChatPacket},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundPlayerChat, F: p.handlePlayerChatPacket},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundDisguisedChat, F: p.handleDisguisedChatPacket},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundPlayerInfoUpdate, F: p.handlePlayerInfoUpdatePacket},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundPlayerInfoRemove, F: p.handlePlayerInfoRemovePacket},
 	)
+	if err != nil {
+		return nil, Error{err}
+	}
 
-	events.attach(p)
+	err = events.attach(p)
+	if err != nil {
+		return nil, Error{err}
+	}
 
-	return p
+	return p, nil
 }
 
 func (p *Player) Respawn() error {
@@ -44,6 +69,20 @@ func (p *Player) Respawn() error {
 	return nil
 }
 
+// Command runs a chat command, given without the leading slash. Commands
+// with arguments the server wants signed, like /msg, are rejected.
+func (p *Player) Command(command string) error {
+	err := p.c.Conn.WritePacket(pk.Marshal(
+		packetid.ServerboundChatCommand,
+		pk.String(command),
+	))
+	if err != nil {
+		return Error{err}
+	}
+
+	return nil
+}
+
 type Error struct {
 	Err error
 }
@@ -51,3 +90,7 @@ type Error struct {
 func (e Error) Error() string {
 	return "bot/basic: " + e.Err.Error()
 }
+
+func (e Error) Unwrap() error {
+	return e.Err
+}
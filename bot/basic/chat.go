@@ -0,0 +1,145 @@
+package basic
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/bot"
+	"mcAfkGo/chat"
+	pk "mcAfkGo/net/packet"
+)
+
+// signatureLen is the length of a message signature.
+const signatureLen = 256
+
+// filterPartial is the filter type of a message with some of its words
+// filtered, followed by a bit set of them.
+const filterPartial = 2
+
+func (p *Player) handleSystemChatPacket(packet pk.Packet) error {
+	var content chat.Message
+	var overlay pk.Boolean
+
+	err := packet.Scan(&content, &overlay)
+	if err != nil {
+		return Error{err}
+	}
+
+	bot.Publish(p.c.Bus, ChatReceived{Message: content, Overlay: bool(overlay)})
+
+	return nil
+}
+
+func (p *Player) handlePlayerChatPacket(packet pk.Packet) error {
+	r := bytes.NewReader(packet.Data)
+
+	var (
+		sender       pk.UUID
+		index        pk.VarInt
+		hasSignature pk.Boolean
+	)
+
+	_, err := pk.Tuple{&sender, &index, &hasSignature}.ReadFrom(r)
+	if err != nil {
+		return Error{err}
+	}
+
+	if hasSignature {
+		_, err = r.Seek(signatureLen, io.SeekCurrent)
+		if err != nil {
+			return Error{err}
+		}
+	}
+
+	var (
+		message   pk.String
+		timestamp pk.Long
+		salt      pk.Long
+		previous  pk.VarInt
+	)
+
+	_, err = pk.Tuple{&message, &timestamp, &salt, &previous}.ReadFrom(r)
+	if err != nil {
+		return Error{err}
+	}
+
+	// Previous messages are referred to by id plus one, or given as their
+	// signature after a 0.
+	for range previous {
+		var id pk.VarInt
+		_, err = id.ReadFrom(r)
+		if err != nil {
+			return Error{err}
+		}
+
+		if id == 0 {
+			_, err = r.Seek(signatureLen, io.SeekCurrent)
+			if err != nil {
+				return Error{err}
+			}
+		}
+	}
+
+	var (
+		unsigned   pk.OptionDecoder[chat.Message, *chat.Message]
+		filterType pk.VarInt
+	)
+
+	_, err = pk.Tuple{&unsigned, &filterType}.ReadFrom(r)
+	if err != nil {
+		return Error{err}
+	}
+
+	if filterType == filterPartial {
+		var filtered pk.BitSet
+		_, err = filtered.ReadFrom(r)
+		if err != nil {
+			return Error{err}
+		}
+	}
+
+	var chatType chat.Type
+	_, err = chatType.ReadFrom(r)
+	if err != nil {
+		return Error{err}
+	}
+
+	content := chat.Text(string(message))
+	if unsigned.Has {
+		content = unsigned.Val
+	}
+
+	bot.Publish(p.c.Bus, ChatReceived{Message: p.decorate(content, &chatType), Sender: uuid.UUID(sender)})
+
+	return nil
+}
+
+// handleDisguisedChatPacket handles chat messages sent without a player
+// behind them, e.g. by /say from the console.
+func (p *Player) handleDisguisedChatPacket(packet pk.Packet) error {
+	var content chat.Message
+	var chatType chat.Type
+
+	err := packet.Scan(&content, &chatType)
+	if err != nil {
+		return Error{err}
+	}
+
+	bot.Publish(p.c.Bus, ChatReceived{Message: p.decorate(content, &chatType)})
+
+	return nil
+}
+
+// decorate returns content as the chat type t shows it.
+func (p *Player) decorate(content chat.Message, t *chat.Type) chat.Message {
+	// Chat types are sent as their id plus one, 0 meaning one given inline
+	// which isn't supported.
+	ct := p.c.Registries.ChatType.GetByID(t.ID - 1)
+	if ct == nil {
+		return content
+	}
+
+	return ct.Chat.Decorate(content, t)
+}
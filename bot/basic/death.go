@@ -0,0 +1,129 @@
+package basic
+
+import (
+	"mcAfkGo/bot"
+	"mcAfkGo/chat"
+	pk "mcAfkGo/net/packet"
+)
+
+// Death tells how the player died.
+type Death struct {
+	// Message is the death message, as shown on the death screen.
+	Message chat.Message
+	// Cause is the damage type of the last damage the player took, e.g.
+	// "minecraft:arrow", "" if it took none.
+	Cause string
+	// KillerID is the entity the last damage is attributed to, like the
+	// skeleton, and DirectID the one that hit, like its arrow. Both are -1
+	// if there is none.
+	KillerID int32
+	DirectID int32
+}
+
+// damage is the last damage the player took.
+type damage struct {
+	typ            int32
+	killer, direct int32
+	ok             bool
+}
+
+func (p *Player) handleDamageEventPacket(packet pk.Packet) error {
+	var id, typ, cause, direct pk.VarInt
+	if err := packet.Scan(&id, &typ, &cause, &direct); err != nil {
+		return Error{err}
+	}
+
+	if int32(id) != p.EID {
+		return nil
+	}
+
+	// The entity ids are sent plus one, 0 meaning none.
+	p.lastDamage = damage{typ: int32(typ), killer: int32(cause) - 1, direct: int32(direct) - 1, ok: true}
+
+	return nil
+}
+
+// handleSetHealthPacket takes the player's health dropping to 0 for its
+// death if the death screen didn't come first, as it does with vanilla
+// servers.
+func (p *Player) handleSetHealthPacket(packet pk.Packet) error {
+	err := packet.Scan(
+		(*pk.Float)(&p.Health),
+		(*pk.VarInt)(&p.Food),
+		(*pk.Float)(&p.Saturation),
+	)
+	if err != nil {
+		return Error{err}
+	}
+
+	bot.Publish(p.c.Bus, HealthChanged{Health: p.Health, Food: p.Food, Saturation: p.Saturation})
+
+	if p.Health > 0 {
+		p.dead = false
+
+		return nil
+	}
+
+	return p.died(nil)
+}
+
+// handlePlayerCombatKillPacket handles the death screen of the player.
+func (p *Player) handlePlayerCombatKillPacket(packet pk.Packet) error {
+	var id pk.VarInt
+	var message chat.Message
+
+	err := packet.Scan(&id, &message)
+	if err != nil {
+		return Error{err}
+	}
+
+	if int32(id) != p.EID {
+		return nil
+	}
+
+	return p.died(&message)
+}
+
+// died reports the death of the player once, with message, or one made up
+// from the last damage if message is nil.
+func (p *Player) died(message *chat.Message) error {
+	if p.dead {
+		return nil
+	}
+
+	p.dead = true
+
+	d := p.death(message)
+	bot.Publish(p.c.Bus, Died{d})
+
+	if p.deathHandler != nil {
+		return p.deathHandler(d)
+	}
+
+	return nil
+}
+
+// death returns the death of the player after its last damage, with
+// message, or one made up from the damage type if message is nil.
+func (p *Player) death(message *chat.Message) Death {
+	d := Death{KillerID: -1, DirectID: -1}
+
+	key := "death.attack.generic"
+	if p.lastDamage.ok {
+		d.Cause = p.c.Registries.DamageType.Key(p.lastDamage.typ)
+		d.KillerID, d.DirectID = p.lastDamage.killer, p.lastDamage.direct
+
+		if t := p.c.Registries.DamageType.GetByID(p.lastDamage.typ); t != nil {
+			// The killer's name isn't known here.
+			key = t.DeathMessageKey(false)
+		}
+	}
+
+	if message != nil {
+		d.Message = *message
+	} else {
+		d.Message = chat.Message{Translate: key, With: chat.TranslateArgs{chat.Text(p.c.Auth.Name)}}
+	}
+
+	return d
+}
@@ -1,39 +1,57 @@
 package basic
 
 import (
+	"github.com/google/uuid"
+
 	"mcAfkGo/bot"
 	"mcAfkGo/chat"
 	"mcAfkGo/data/packetid"
+	"mcAfkGo/maths"
 	pk "mcAfkGo/net/packet"
 )
 
+// EventsListener has callbacks run on the goroutine handling the packets,
+// before any subscriber of the bus gets the event. Errors they return are
+// the packet handler's.
 type EventsListener struct {
 	GameStart  func() error
 	Disconnect func(reason chat.Message) error
-	Death      func() error
+	Death      func(d Death) error
 }
 
-func (e EventsListener) attach(p *Player) {
+func (e EventsListener) attach(p *Player) error {
 	if e.GameStart != nil {
-		attachJoinGameHandler(p.c, e.GameStart)
+		err := attachJoinGameHandler(p.c, e.GameStart)
+		if err != nil {
+			return err
+		}
 	}
 
 	if e.Disconnect != nil {
-		attachDisconnect(p.c, e.Disconnect)
+		err := attachDisconnect(p.c, e.Disconnect)
+		if err != nil {
+			return err
+		}
 	}
+
+	p.deathHandler = e.Death
+
+	return nil
 }
 
-func attachJoinGameHandler(c *bot.Client, handler func() error) {
-	c.Events.AddListener(bot.PacketHandler{
+func attachJoinGameHandler(c *bot.Client, handler func() error) error {
+	_, err := c.Events.AddListener(bot.PacketHandler{
 		Priority: 64, ID: packetid.ClientboundLogin,
 		F: func(_ pk.Packet) error {
 			return handler()
 		},
 	})
+
+	return err
 }
 
-func attachDisconnect(c *bot.Client, handler func(reason chat.Message) error) {
-	c.Events.AddListener(bot.PacketHandler{
+func attachDisconnect(c *bot.Client, handler func(reason chat.Message) error) error {
+	_, err := c.Events.AddListener(bot.PacketHandler{
 		Priority: 64, ID: packetid.ClientboundDisconnect,
 		F: func(p pk.Packet) error {
 			var reason chat.Message
@@ -44,4 +62,56 @@ func attachDisconnect(c *bot.Client, handler func(reason chat.Message) error) {
 			return handler(reason)
 		},
 	})
+
+	return err
 }
+
+// The events published on the client's bus.
+type (
+	// GameStart is published when the player joined the world.
+	GameStart struct{}
+
+	// Respawned is published when the player respawned, after dying or
+	// switching dimensions.
+	Respawned struct {
+		Dimension string
+	}
+
+	// Died is published when the player died.
+	Died struct {
+		Death
+	}
+
+	HealthChanged struct {
+		Health     float32
+		Food       int32
+		Saturation float32
+	}
+
+	// Teleported is published when the server moved the player.
+	Teleported struct {
+		Position   maths.Vec3
+		Yaw, Pitch float32
+	}
+
+	// ChatReceived is published for every chat message shown to the
+	// player. Sender is uuid.Nil for messages of the server, Overlay is set
+	// for the ones shown above the hotbar.
+	ChatReceived struct {
+		Message chat.Message
+		Sender  uuid.UUID
+		Overlay bool
+	}
+
+	// PlayerJoined is published for every player in the player list, the
+	// ones online already included.
+	PlayerJoined struct {
+		UUID uuid.UUID
+		Name string
+	}
+
+	PlayerLeft struct {
+		UUID uuid.UUID
+		Name string
+	}
+)
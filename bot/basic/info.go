@@ -3,7 +3,9 @@ package basic
 import (
 	"unsafe"
 
+	"mcAfkGo/bot"
 	"mcAfkGo/data/packetid"
+	"mcAfkGo/maths"
 	pk "mcAfkGo/net/packet"
 )
 
@@ -27,6 +29,13 @@ type PlayerInfo struct {
 	Hardcore     bool  `desc:"Is hardcore"`
 	Gamemode     byte  `desc:"GameMode. 0: Survival, 1: Creative, 2: Adventure, 3: Spectator."`
 	PrevGamemode int8  `desc:"Previous GameMode"`
+
+	Position   maths.Vec3 `desc:"Where the server last teleported the player."`
+	Yaw, Pitch float32
+
+	Health     float32 `desc:"0 or less means dead."`
+	Food       int32   `desc:"0-20."`
+	Saturation float32 `desc:"0.0-5.0 in integer increments."`
 }
 
 func (p *Player) handleLoginPacket(packet pk.Packet) error {
@@ -77,6 +86,7 @@ func (p *Player) handleLoginPacket(packet pk.Packet) error {
 	}
 
 	p.resetKeepAliveDeadline()
+	bot.Publish(p.c.Bus, GameStart{})
 
 	return nil
 }
@@ -97,5 +107,8 @@ func (p *Player) handleRespawnPacket(packet pk.Packet) error {
 		return Error{err}
 	}
 
+	p.dead, p.lastDamage = false, damage{}
+	bot.Publish(p.c.Bus, Respawned{Dimension: p.DimensionName})
+
 	return nil
 }
@@ -0,0 +1,148 @@
+package basic
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/bot"
+	"mcAfkGo/chat"
+	pk "mcAfkGo/net/packet"
+)
+
+// The actions of the player info update packet, in the order their data
+// follows for every player.
+const (
+	actionAddPlayer = 1 << iota
+	actionInitializeChat
+	actionUpdateGameMode
+	actionUpdateListed
+	actionUpdateLatency
+	actionUpdateDisplayName
+)
+
+func (p *Player) handlePlayerInfoUpdatePacket(packet pk.Packet) error {
+	r := bytes.NewReader(packet.Data)
+
+	var actions pk.Byte
+	var count pk.VarInt
+
+	_, err := pk.Tuple{&actions, &count}.ReadFrom(r)
+	if err != nil {
+		return Error{err}
+	}
+
+	for range count {
+		var id pk.UUID
+		_, err = id.ReadFrom(r)
+		if err != nil {
+			return Error{err}
+		}
+
+		var name pk.String
+		if actions&actionAddPlayer != 0 {
+			var properties []property
+			_, err = pk.Tuple{&name, pk.Array(&properties)}.ReadFrom(r)
+			if err != nil {
+				return Error{err}
+			}
+		}
+
+		if actions&actionInitializeChat != 0 {
+			var session pk.OptionDecoder[chatSession, *chatSession]
+			_, err = session.ReadFrom(r)
+			if err != nil {
+				return Error{err}
+			}
+		}
+
+		if actions&actionUpdateGameMode != 0 {
+			var gameMode pk.VarInt
+			_, err = gameMode.ReadFrom(r)
+			if err != nil {
+				return Error{err}
+			}
+		}
+
+		if actions&actionUpdateListed != 0 {
+			var listed pk.Boolean
+			_, err = listed.ReadFrom(r)
+			if err != nil {
+				return Error{err}
+			}
+		}
+
+		if actions&actionUpdateLatency != 0 {
+			var latency pk.VarInt
+			_, err = latency.ReadFrom(r)
+			if err != nil {
+				return Error{err}
+			}
+		}
+
+		if actions&actionUpdateDisplayName != 0 {
+			var displayName pk.OptionDecoder[chat.Message, *chat.Message]
+			_, err = displayName.ReadFrom(r)
+			if err != nil {
+				return Error{err}
+			}
+		}
+
+		if actions&actionAddPlayer == 0 {
+			continue
+		}
+
+		if _, ok := p.players[uuid.UUID(id)]; ok {
+			continue
+		}
+
+		p.players[uuid.UUID(id)] = string(name)
+		bot.Publish(p.c.Bus, PlayerJoined{UUID: uuid.UUID(id), Name: string(name)})
+	}
+
+	return nil
+}
+
+func (p *Player) handlePlayerInfoRemovePacket(packet pk.Packet) error {
+	var ids []pk.UUID
+
+	err := packet.Scan(pk.Array(&ids))
+	if err != nil {
+		return Error{err}
+	}
+
+	for _, id := range ids {
+		name, ok := p.players[uuid.UUID(id)]
+		if !ok {
+			continue
+		}
+
+		delete(p.players, uuid.UUID(id))
+		bot.Publish(p.c.Bus, PlayerLeft{UUID: uuid.UUID(id), Name: name})
+	}
+
+	return nil
+}
+
+// property is a property of a player's profile, like its skin.
+type property struct {
+	Name, Value pk.String
+	Signature   pk.OptionDecoder[pk.String, *pk.String]
+}
+
+func (p *property) ReadFrom(r io.Reader) (int64, error) {
+	return pk.Tuple{&p.Name, &p.Value, &p.Signature}.ReadFrom(r)
+}
+
+// chatSession is the key a player signs its chat messages with.
+type chatSession struct {
+	ID        pk.UUID
+	ExpiresAt pk.Long
+	PublicKey pk.ByteArray
+	Signature pk.ByteArray
+}
+
+func (s *chatSession) ReadFrom(r io.Reader) (int64, error) {
+	return pk.Tuple{&s.ID, &s.ExpiresAt, &s.PublicKey, &s.Signature}.ReadFrom(r)
+}
@@ -0,0 +1,77 @@
+package basic
+
+import (
+	"mcAfkGo/bot"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/maths"
+	pk "mcAfkGo/net/packet"
+)
+
+// Flags of the player position packet telling which values are relative.
+const (
+	relativeX = 1 << iota
+	relativeY
+	relativeZ
+	relativeYaw
+	relativePitch
+)
+
+// handlePlayerPositionPacket moves the player where the server says and
+// confirms the teleport, as the vanilla client does.
+func (p *Player) handlePlayerPositionPacket(packet pk.Packet) error {
+	var (
+		x, y, z    pk.Double
+		yaw, pitch pk.Float
+		flags      pk.Byte
+		teleportID pk.VarInt
+	)
+
+	err := packet.Scan(&x, &y, &z, &yaw, &pitch, &flags, &teleportID)
+	if err != nil {
+		return Error{err}
+	}
+
+	pos := maths.Vec3{X: float64(x), Y: float64(y), Z: float64(z)}
+	if flags&relativeX != 0 {
+		pos.X += p.Position.X
+	}
+
+	if flags&relativeY != 0 {
+		pos.Y += p.Position.Y
+	}
+
+	if flags&relativeZ != 0 {
+		pos.Z += p.Position.Z
+	}
+
+	if flags&relativeYaw != 0 {
+		yaw += pk.Float(p.Yaw)
+	}
+
+	if flags&relativePitch != 0 {
+		pitch += pk.Float(p.Pitch)
+	}
+
+	p.Position, p.Yaw, p.Pitch = pos, float32(yaw), float32(pitch)
+	bot.Publish(p.c.Bus, Teleported{Position: pos, Yaw: p.Yaw, Pitch: p.Pitch})
+
+	err = p.c.Conn.WritePacket(pk.Marshal(
+		packetid.ServerboundAcceptTeleportation,
+		teleportID,
+	))
+	if err != nil {
+		return Error{err}
+	}
+
+	err = p.c.Conn.WritePacket(pk.Marshal(
+		packetid.ServerboundMovePlayerPosRot,
+		pk.Double(pos.X), pk.Double(pos.Y), pk.Double(pos.Z),
+		yaw, pitch,
+		pk.Boolean(false),
+	))
+	if err != nil {
+		return Error{err}
+	}
+
+	return nil
+}
@@ -0,0 +1,413 @@
+// Package bottest provides a local Minecraft server for end-to-end tests of
+// bot clients. It speaks the handshake, login and configuration phases and
+// hands every phase to an optional script, so tests can disconnect, kick,
+// transfer or stall the client at any point.
+package bottest
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/chat"
+	"mcAfkGo/data/packetid"
+	mcnet "mcAfkGo/net"
+	"mcAfkGo/net/CFB8"
+	pk "mcAfkGo/net/packet"
+)
+
+type State string
+
+const (
+	StateHandshake     State = "handshake"
+	StateLogin         State = "login"
+	StateConfiguration State = "configuration"
+	StatePlay          State = "play"
+)
+
+// DefaultTimeout bounds every read a session waits for.
+const DefaultTimeout = 5 * time.Second
+
+// Server accepts bot connections on a local port. The exported fields must
+// be set before Start.
+type Server struct {
+	// Addr is the address the server listens on, ready for JoinServer.
+	Addr     string
+	Listener *mcnet.Listener
+
+	// CompressionThreshold enables compression after login when >= 0.
+	CompressionThreshold int
+
+	// Encryption makes the server send an encryption request. With
+	// OnlineMode the client is also asked to join the session server and
+	// HasJoined, if set, checks the server hash it should have used.
+	Encryption bool
+	OnlineMode bool
+	HasJoined  func(name, serverHash string) error
+
+	// Login runs after the client's login start, Configuration before the
+	// server finishes the configuration and Play once the client entered
+	// the play state. Without Play the server waits for the client to
+	// leave. A script ends the session by returning or by calling
+	// Session.Disconnect.
+	Login         func(s *Session) error
+	Configuration func(s *Session) error
+	Play          func(s *Session) error
+
+	// Timeout bounds every read, DefaultTimeout if zero.
+	Timeout time.Duration
+
+	key *rsa.PrivateKey
+	wg  sync.WaitGroup
+	// running counts the sessions not ended yet.
+	running sync.WaitGroup
+
+	mu       sync.Mutex
+	sessions map[*Session]struct{}
+	errs     []error
+}
+
+// NewServer starts a server running play as its play phase script.
+func NewServer(play func(s *Session) error) *Server {
+	srv := NewUnstartedServer()
+	srv.Play = play
+	srv.Start()
+
+	return srv
+}
+
+// NewUnstartedServer returns a server without compression and encryption
+// that can be configured before calling Start.
+func NewUnstartedServer() *Server {
+	return &Server{
+		CompressionThreshold: -1,
+		sessions:             make(map[*Session]struct{}),
+	}
+}
+
+func (srv *Server) Start() {
+	if srv.Listener != nil {
+		panic("bottest: server already started")
+	}
+
+	l, err := mcnet.ListenMC("127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("bottest: failed to listen: %v", err))
+	}
+
+	if srv.Encryption {
+		srv.key, err = rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			panic(fmt.Sprintf("bottest: failed to generate key: %v", err))
+		}
+	}
+
+	srv.Listener = l
+	srv.Addr = l.Addr().String()
+
+	srv.wg.Add(1)
+	go srv.acceptLoop()
+}
+
+// Close stops accepting connections, closes the open sessions and waits
+// until their scripts returned.
+func (srv *Server) Close() {
+	_ = srv.Listener.Close()
+
+	srv.mu.Lock()
+	for s := range srv.sessions {
+		_ = s.Conn.Close()
+	}
+	srv.mu.Unlock()
+
+	srv.wg.Wait()
+}
+
+// Wait waits until the sessions accepted so far ended, e.g. after the
+// client closed the connection.
+func (srv *Server) Wait() {
+	srv.running.Wait()
+}
+
+// Err returns the errors the sessions ended with, nil if all of them
+// completed normally.
+func (srv *Server) Err() error {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	return errors.Join(srv.errs...)
+}
+
+func (srv *Server) acceptLoop() {
+	defer srv.wg.Done()
+
+	for {
+		conn, err := srv.Listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s := &Session{Conn: conn, State: StateHandshake, timeout: srv.Timeout}
+		if s.timeout == 0 {
+			s.timeout = DefaultTimeout
+		}
+
+		srv.mu.Lock()
+		srv.sessions[s] = struct{}{}
+		srv.mu.Unlock()
+
+		srv.wg.Add(1)
+		srv.running.Add(1)
+		go func() {
+			defer srv.wg.Done()
+			defer srv.running.Done()
+
+			err := srv.serve(s)
+			_ = s.Conn.Close()
+
+			srv.mu.Lock()
+			delete(srv.sessions, s)
+			if err != nil {
+				srv.errs = append(srv.errs, err)
+			}
+			srv.mu.Unlock()
+		}()
+	}
+}
+
+func (srv *Server) serve(s *Session) error {
+	err := s.handshake()
+	if err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+
+	s.State = StateLogin
+	err = srv.login(s)
+	if err != nil || s.closed {
+		return wrapStage("login", err)
+	}
+
+	s.State = StateConfiguration
+	err = srv.configure(s)
+	if err != nil || s.closed {
+		return wrapStage("configuration", err)
+	}
+
+	s.State = StatePlay
+	if srv.Play == nil {
+		return s.waitClosed()
+	}
+
+	return wrapStage("play", srv.Play(s))
+}
+
+func wrapStage(stage string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %w", stage, err)
+}
+
+func (srv *Server) login(s *Session) error {
+	p, err := s.Expect(packetid.ServerboundLoginHello)
+	if err != nil {
+		return err
+	}
+
+	err = p.Scan((*pk.String)(&s.Name), (*pk.UUID)(&s.UUID))
+	if err != nil {
+		return err
+	}
+
+	if srv.Login != nil {
+		err = srv.Login(s)
+		if err != nil || s.closed {
+			return err
+		}
+	}
+
+	if srv.Encryption {
+		err = srv.encrypt(s)
+		if err != nil || s.closed {
+			return err
+		}
+	}
+
+	if srv.CompressionThreshold >= 0 {
+		err = s.WritePacket(pk.Marshal(
+			packetid.ClientboundLoginLoginCompression,
+			pk.VarInt(srv.CompressionThreshold),
+		))
+		if err != nil {
+			return err
+		}
+
+		s.Conn.SetThreshold(srv.CompressionThreshold)
+	}
+
+	err = s.WritePacket(pk.Marshal(
+		packetid.ClientboundLoginGameProfile,
+		pk.UUID(s.UUID),
+		pk.String(s.Name),
+		pk.VarInt(0), // properties
+		pk.Boolean(true),
+	))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Expect(packetid.ServerboundLoginLoginAcknowledged)
+
+	return err
+}
+
+func (srv *Server) encrypt(s *Session) error {
+	publicKey, err := x509.MarshalPKIXPublicKey(&srv.key.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	verifyToken := make([]byte, 4)
+	_, err = rand.Read(verifyToken)
+	if err != nil {
+		return err
+	}
+
+	err = s.WritePacket(pk.Marshal(
+		packetid.ClientboundLoginHello,
+		pk.String(""),
+		pk.ByteArray(publicKey),
+		pk.ByteArray(verifyToken),
+		pk.Boolean(srv.OnlineMode),
+	))
+	if err != nil {
+		return err
+	}
+
+	p, err := s.Expect(packetid.ServerboundLoginKey)
+	if err != nil {
+		return err
+	}
+
+	var encSecret, encToken pk.ByteArray
+	err = p.Scan(&encSecret, &encToken)
+	if err != nil {
+		return err
+	}
+
+	secret, err := rsa.DecryptPKCS1v15(rand.Reader, srv.key, encSecret)
+	if err != nil {
+		return fmt.Errorf("decrypt shared secret: %w", err)
+	}
+
+	token, err := rsa.DecryptPKCS1v15(rand.Reader, srv.key, encToken)
+	if err != nil {
+		return fmt.Errorf("decrypt verify token: %w", err)
+	}
+
+	if !bytes.Equal(token, verifyToken) {
+		return errors.New("verify token mismatch")
+	}
+
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		return err
+	}
+
+	s.Conn.SetCipher(CFB8.NewCFB8Encrypt(block, secret), CFB8.NewCFB8Decrypt(block, secret))
+
+	if !srv.OnlineMode {
+		return nil
+	}
+
+	s.ServerHash = serverHash("", secret, publicKey)
+	if srv.HasJoined == nil {
+		return nil
+	}
+
+	err = srv.HasJoined(s.Name, s.ServerHash)
+	if err != nil {
+		_ = s.Disconnect(chat.Text("Failed to verify username!"))
+
+		return err
+	}
+
+	return nil
+}
+
+func (srv *Server) configure(s *Session) error {
+	if srv.Configuration != nil {
+		err := srv.Configuration(s)
+		if err != nil || s.closed {
+			return err
+		}
+	}
+
+	err := s.WritePacket(pk.Marshal(packetid.ClientboundConfigFinishConfiguration))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Expect(packetid.ServerboundConfigFinishConfiguration)
+
+	return err
+}
+
+// serverHash is the digest the client sends to the session server, the
+// same as the one computed in bot.
+func serverHash(serverID string, sharedSecret, publicKey []byte) string {
+	h := sha1.New()
+	h.Write([]byte(serverID))
+	h.Write(sharedSecret)
+	h.Write(publicKey)
+	hash := h.Sum(nil)
+
+	negative := (hash[0] & 0x80) == 0x80
+	if negative {
+		carry := true
+		for i := len(hash) - 1; i >= 0; i-- {
+			hash[i] = ^hash[i]
+			if carry {
+				carry = hash[i] == 0xff
+				hash[i]++
+			}
+		}
+	}
+
+	res := strings.TrimLeft(hex.EncodeToString(hash), "0")
+	if negative {
+		res = "-" + res
+	}
+
+	return res
+}
+
+// Session is one client connection. Its fields are filled in as the client
+// gets through the phases.
+type Session struct {
+	Conn  *mcnet.Conn
+	State State
+
+	ProtocolVersion int32
+	Host            string
+	Port            uint16
+
+	Name       string
+	UUID       uuid.UUID
+	ServerHash string
+
+	timeout time.Duration
+	closed  bool
+}
@@ -0,0 +1,233 @@
+package bottest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/chat"
+	"mcAfkGo/data/packetid"
+	pk "mcAfkGo/net/packet"
+)
+
+func (s *Session) handshake() error {
+	const Handshake = 0x00
+
+	var p pk.Packet
+	err := s.ReadPacket(&p)
+	if err != nil {
+		return err
+	}
+
+	if p.ID != Handshake {
+		return fmt.Errorf("unexpected packet 0x%02x", p.ID)
+	}
+
+	var (
+		protocol  pk.VarInt
+		host      pk.String
+		port      pk.UnsignedShort
+		nextState pk.VarInt
+	)
+
+	err = p.Scan(&protocol, &host, &port, &nextState)
+	if err != nil {
+		return err
+	}
+
+	s.ProtocolVersion, s.Host, s.Port = int32(protocol), string(host), uint16(port)
+	if nextState != 2 {
+		return fmt.Errorf("unsupported next state %d", nextState)
+	}
+
+	return nil
+}
+
+// ReadPacket reads the next packet, failing if none arrives within the
+// server's timeout.
+func (s *Session) ReadPacket(p *pk.Packet) error {
+	err := s.Conn.Socket.SetReadDeadline(time.Now().Add(s.timeout))
+	if err != nil {
+		return err
+	}
+
+	return s.Conn.ReadPacket(p)
+}
+
+func (s *Session) WritePacket(p pk.Packet) error {
+	return s.Conn.WritePacket(p)
+}
+
+// Expect reads packets until one with the given ID arrives and returns it.
+// IDs are those of the session's current state.
+func (s *Session) Expect(id packetid.ServerboundPacketID) (pk.Packet, error) {
+	for {
+		var p pk.Packet
+		err := s.ReadPacket(&p)
+		if err != nil {
+			return p, fmt.Errorf("waiting for %v: %w", id, err)
+		}
+
+		if p.ID == int32(id) {
+			return p, nil
+		}
+	}
+}
+
+// waitClosed discards packets until the client closes the connection.
+func (s *Session) waitClosed() error {
+	for {
+		var p pk.Packet
+		err := s.Conn.ReadPacket(&p)
+		if err != nil {
+			return nil
+		}
+	}
+}
+
+// Disconnect kicks the client with reason and ends the session once the
+// running script returns.
+func (s *Session) Disconnect(reason chat.Message) error {
+	var p pk.Packet
+
+	switch s.State {
+	case StateLogin:
+		p = pk.Marshal(packetid.ClientboundLoginLoginDisconnect, chat.JsonMessage(reason))
+	case StateConfiguration:
+		p = pk.Marshal(packetid.ClientboundConfigDisconnect, reason)
+	case StatePlay:
+		p = pk.Marshal(packetid.ClientboundDisconnect, reason)
+	default:
+		return fmt.Errorf("cannot disconnect in %s state", s.State)
+	}
+
+	s.closed = true
+
+	return s.WritePacket(p)
+}
+
+// KeepAlive sends a keep alive and waits for the client to echo it.
+func (s *Session) KeepAlive(id int64) error {
+	var ping packetid.ClientboundPacketID
+	var pong packetid.ServerboundPacketID
+
+	switch s.State {
+	case StateConfiguration:
+		ping, pong = packetid.ClientboundConfigKeepAlive, packetid.ServerboundConfigKeepAlive
+	case StatePlay:
+		ping, pong = packetid.ClientboundKeepAlive, packetid.ServerboundKeepAlive
+	default:
+		return fmt.Errorf("no keep alive in %s state", s.State)
+	}
+
+	err := s.WritePacket(pk.Marshal(ping, pk.Long(id)))
+	if err != nil {
+		return err
+	}
+
+	p, err := s.Expect(pong)
+	if err != nil {
+		return err
+	}
+
+	var got pk.Long
+	err = p.Scan(&got)
+	if err != nil {
+		return err
+	}
+
+	if int64(got) != id {
+		return fmt.Errorf("keep alive answered with %d, want %d", got, id)
+	}
+
+	return nil
+}
+
+// Reconfigure takes the client in the play state back to the configuration
+// state, runs configure there if not nil and returns to the play state.
+func (s *Session) Reconfigure(configure func(s *Session) error) error {
+	if s.State != StatePlay {
+		return fmt.Errorf("cannot reconfigure in %s state", s.State)
+	}
+
+	err := s.WritePacket(pk.Marshal(packetid.ClientboundStartConfiguration))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Expect(packetid.ServerboundConfigurationAcknowledged)
+	if err != nil {
+		return err
+	}
+
+	s.State = StateConfiguration
+	if configure != nil {
+		err = configure(s)
+		if err != nil || s.closed {
+			return err
+		}
+	}
+
+	err = s.WritePacket(pk.Marshal(packetid.ClientboundConfigFinishConfiguration))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Expect(packetid.ServerboundConfigFinishConfiguration)
+	if err != nil {
+		return err
+	}
+
+	s.State = StatePlay
+
+	return nil
+}
+
+// Transfer tells the client to connect to host:port instead, and ends the
+// session once the running script returns as the client leaves.
+func (s *Session) Transfer(host string, port int) error {
+	var p pk.Packet
+
+	switch s.State {
+	case StateConfiguration:
+		p = pk.Marshal(packetid.ClientboundConfigTransfer, pk.String(host), pk.VarInt(port))
+	case StatePlay:
+		p = pk.Marshal(packetid.ClientboundTransfer, pk.String(host), pk.VarInt(port))
+	default:
+		return fmt.Errorf("cannot transfer in %s state", s.State)
+	}
+
+	s.closed = true
+
+	return s.WritePacket(p)
+}
+
+// PushResourcePack prompts the client to download a resource pack. prompt
+// may be nil.
+func (s *Session) PushResourcePack(id uuid.UUID, url, hash string, forced bool, prompt *chat.Message) error {
+	var packetID packetid.ClientboundPacketID
+
+	switch s.State {
+	case StateConfiguration:
+		packetID = packetid.ClientboundConfigResourcePackPush
+	case StatePlay:
+		packetID = packetid.ClientboundResourcePackPush
+	default:
+		return fmt.Errorf("no resource packs in %s state", s.State)
+	}
+
+	var msg pk.Option[chat.Message, *chat.Message]
+	if prompt != nil {
+		msg = pk.Option[chat.Message, *chat.Message]{Has: true, Val: *prompt}
+	}
+
+	return s.WritePacket(pk.Marshal(
+		packetID,
+		pk.UUID(id),
+		pk.String(url),
+		pk.String(hash),
+		pk.Boolean(forced),
+		msg,
+	))
+}
@@ -0,0 +1,132 @@
+package bot
+
+import (
+	"reflect"
+	"sync"
+
+	"mcAfkGo/chat"
+	"mcAfkGo/net/queue"
+)
+
+// Bus delivers typed events, like Connected or basic.Died, to the
+// functions subscribed to their type. Every subscriber has a queue and a
+// goroutine of its own, so a slow one never holds up the publisher or the
+// other subscribers.
+type Bus struct {
+	mu     sync.Mutex
+	subs   map[reflect.Type][]*subscriber
+	closed bool
+}
+
+type subscriber struct {
+	events queue.Queue[any]
+}
+
+func NewBus() *Bus {
+	return &Bus{subs: make(map[reflect.Type][]*subscriber)}
+}
+
+// Subscribe calls f with every event of type E published on b, in the
+// order they were published. Calling the returned function unsubscribes f,
+// events already published are still delivered.
+func Subscribe[E any](b *Bus, f func(E)) (unsubscribe func()) {
+	typ := reflect.TypeFor[E]()
+	s := &subscriber{events: queue.NewLinkedQueue[any]()}
+
+	go func() {
+		for {
+			e, ok := s.events.Pull()
+			if !ok {
+				return
+			}
+
+			f(e.(E))
+		}
+	}()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		s.events.Close()
+
+		return func() {}
+	}
+
+	b.subs[typ] = append(b.subs[typ], s)
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			subs := b.subs[typ]
+			for i, other := range subs {
+				if other == s {
+					b.subs[typ] = append(subs[:i:i], subs[i+1:]...)
+					s.events.Close()
+
+					break
+				}
+			}
+		})
+	}
+}
+
+// Publish hands e to the subscribers of its type without waiting for them.
+func Publish[E any](b *Bus, e E) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, s := range b.subs[reflect.TypeFor[E]()] {
+		s.events.Push(e)
+	}
+}
+
+// Close unsubscribes everyone once the events published so far are
+// delivered. Later events are dropped.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.closed = true
+
+	for _, subs := range b.subs {
+		for _, s := range subs {
+			s.events.Close()
+		}
+	}
+
+	clear(b.subs)
+}
+
+// Connected is published once the client joined the server and plays.
+type Connected struct {
+	Address string
+}
+
+// Disconnected is published when the connection to the server is lost.
+// Reason is what the server kicked the client with, if it did.
+type Disconnected struct {
+	Err    error
+	Reason *chat.Message
+}
+
+// Kicked is published when the server disconnects the client while
+// playing.
+type Kicked struct {
+	Reason chat.Message
+}
+
+// Transferred is published when the server sends the client to another
+// server during the configuration. The join fails with a TransferError.
+type Transferred struct {
+	Host string
+	Port int
+}
@@ -1,13 +1,20 @@
 package bot
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	stdnet "net"
 	"sync"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 
+	"mcAfkGo/auth"
+	"mcAfkGo/chat"
 	"mcAfkGo/data/packetid"
 	"mcAfkGo/net"
+	"mcAfkGo/net/capture"
 	pk "mcAfkGo/net/packet"
 	"mcAfkGo/net/queue"
 	"mcAfkGo/registry"
@@ -17,94 +24,265 @@ type Client struct {
 	Conn *Conn
 	Auth Auth
 
+	Authenticator auth.Authenticator
+
 	Name       string
 	UUID       uuid.UUID
 	Registries registry.Registries
 	Cookies    map[string][]byte
 
 	Events Events
+	// Bus carries the typed events of the client and its components.
+	Bus *Bus
 
 	LoginPlugin map[string]CustomPayloadHandler
 
 	ConfigHandler
 
 	CustomReportDetails map[string]string
+
+	// kickReason is the reason of the disconnect packet while playing.
+	kickReason *chat.Message
+	// bundle is the rest of a bundle a handler failed in, which the next
+	// HandleGame goes on with.
+	bundle []pk.Packet
 }
 
 type CustomPayloadHandler func(data []byte) ([]byte, error)
 
+// Close closes the connection and, once they got the events so far, drops
+// the subscribers of the bus.
 func (c *Client) Close() error {
+	c.Bus.Close()
+
+	// The client has no connection before it joined a server.
+	if c.Conn == nil {
+		return nil
+	}
+
 	return c.Conn.Close()
 }
 
 func NewClient() *Client {
-	return &Client{
+	c := &Client{
 		Auth:                Auth{Name: "Steve"},
 		Registries:          registry.NewNetworkCodec(),
-		Events:              Events{handlers: make([][]PacketHandler, packetid.ClientboundPacketIDGuard)},
+		Events:              newEvents(),
+		Bus:                 NewBus(),
 		LoginPlugin:         make(map[string]CustomPayloadHandler),
 		ConfigHandler:       NewDefaultConfigHandler(),
 		CustomReportDetails: make(map[string]string),
 	}
+
+	// The ID is a constant in range, an error is a bug.
+	_, err := c.Events.AddListener(PacketHandler{Priority: 0, ID: packetid.ClientboundDisconnect, F: c.handleDisconnect})
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+func (c *Client) handleDisconnect(p pk.Packet) error {
+	var reason chat.Message
+	if err := p.Scan(&reason); err != nil {
+		return err
+	}
+
+	c.kickReason = &reason
+	Publish(c.Bus, Kicked{Reason: reason})
+
+	return nil
 }
 
+// maxBatch is the most packets written to the socket at once.
+const maxBatch = 64
+
 type Conn struct {
 	*net.Conn
 	send, recv queue.Queue[pk.Packet]
 	pool       sync.Pool
-	rerr       error
+
+	// setState switches the protocol state of the connection, its packet
+	// IDs and the state its packets are captured with.
+	setState func(state capture.State)
+	// resume lets the reader go on after it stopped at the start of a
+	// configuration, done stops it.
+	resume    chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// pushMu keeps queued counting the packets in the order of the queue.
+	pushMu sync.Mutex
+	queued atomic.Uint64
+
+	mu      sync.Mutex
+	rerr    error
+	werr    error
+	written uint64
+	// drained counts the packets queued that were written or dropped by
+	// the send queue, as of the last time the writer found it empty.
+	drained uint64
+	// progress is closed and replaced whenever packets were written or the
+	// writer stopped. It is nil without a writer, as in a replay.
+	progress chan struct{}
 }
 
-func warpConn(c *net.Conn, qr, qw queue.Queue[pk.Packet]) *Conn {
+func warpConn(c *net.Conn, qr, qw queue.Queue[pk.Packet], setState func(state capture.State)) *Conn {
 	wc := Conn{
-		Conn: c,
-		send: qw,
-		recv: qr,
-		pool: sync.Pool{New: func() any { return []byte{} }},
-		rerr: nil,
+		Conn:     c,
+		send:     qw,
+		recv:     qr,
+		pool:     sync.Pool{New: func() any { return []byte{} }},
+		setState: setState,
+		resume:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		progress: make(chan struct{}),
 	}
 
-	go func() {
-		for {
-			p := pk.Packet{Data: wc.pool.Get().([]byte)}
-			err := c.ReadPacket(&p)
-			if err != nil {
-				wc.rerr = err
+	go wc.readLoop()
+	go wc.writeLoop()
 
-				break
-			}
+	return &wc
+}
 
-			ok := wc.recv.Push(p)
-			if !ok {
-				wc.rerr = errors.New("receive queue is full")
+func (c *Conn) readLoop() {
+	defer c.recv.Close()
 
-				break
+	for {
+		p := pk.Packet{Data: c.pool.Get().([]byte)}
+		err := c.Conn.ReadPacket(&p)
+		if err != nil {
+			c.setReadErr(err)
+
+			return
+		}
+
+		// A full queue holds the reading up, which leaves the server to
+		// wait for the connection.
+		ok := c.recv.Push(p)
+		if !ok {
+			c.setReadErr(stdnet.ErrClosed)
+
+			return
+		}
+
+		// The packets after are of the configuration state, which
+		// HandleGame reads itself until it's back in the play state.
+		if p.ID == int32(packetid.ClientboundStartConfiguration) {
+			select {
+			case <-c.resume:
+			case <-c.done:
+				c.setReadErr(stdnet.ErrClosed)
+
+				return
 			}
 		}
+	}
+}
 
-		wc.recv.Close()
-	}()
+// writeLoop writes the packets of the send queue, the ones queued by the
+// time it gets to them in one go. A write error ends the connection.
+func (c *Conn) writeLoop() {
+	puller, _ := c.send.(queue.TryPuller[pk.Packet])
 
-	go func() {
-		for {
-			p, ok := wc.send.Pull()
-			if !ok {
-				break
+	var batch []pk.Packet
+	for {
+		batch = batch[:0]
+
+		// drained is the count of packets queued by the time the queue was
+		// found empty, those not written were dropped by it. queued counts
+		// a packet after it was pushed, so it is loaded before looking.
+		var drained uint64
+		for len(batch) < maxBatch {
+			queued := c.queued.Load()
+			if puller != nil {
+				p, ok := puller.TryPull()
+				if ok {
+					batch = append(batch, p)
+
+					continue
+				}
+
+				drained = queued
 			}
 
-			err := c.WritePacket(p)
-			if err != nil {
+			if len(batch) > 0 {
 				break
 			}
+
+			if drained > 0 {
+				c.wrote(0, drained, nil)
+			}
+
+			p, ok := c.send.Pull()
+			if !ok {
+				c.wrote(0, 0, stdnet.ErrClosed)
+
+				return
+			}
+
+			batch = append(batch, p)
 		}
-	}()
 
-	return &wc
+		err := c.Conn.WritePackets(batch...)
+		if err != nil {
+			c.wrote(0, 0, fmt.Errorf("bot: write packet: %w", err))
+			// Fail the packets sent from now on, and stop the reader so
+			// HandleGame returns the error.
+			c.send.Close()
+			_ = c.Conn.Close()
+
+			return
+		}
+
+		c.wrote(len(batch), drained, nil)
+	}
+}
+
+// wrote records n packets written and the count of packets queued that
+// left the queue, or the error stopping the writer.
+func (c *Conn) wrote(n int, drained uint64, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.written += uint64(n)
+	c.drained = max(c.drained, drained)
+	if err != nil && c.werr == nil {
+		c.werr = err
+	}
+
+	close(c.progress)
+	c.progress = make(chan struct{})
 }
 
+func (c *Conn) setReadErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.rerr == nil {
+		c.rerr = err
+	}
+}
+
+// ReadPacket returns the next packet received. Once the connection failed,
+// writing or reading, it returns the error.
 func (c *Conn) ReadPacket(p *pk.Packet) error {
 	packet, ok := c.recv.Pull()
 	if !ok {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		// The reader fails too after a write error closed the socket.
+		if c.werr != nil && !errors.Is(c.werr, stdnet.ErrClosed) {
+			return c.werr
+		}
+
+		// The queue may be closed by Close before the reader failed.
+		if c.rerr == nil {
+			return stdnet.ErrClosed
+		}
+
 		return c.rerr
 	}
 
@@ -113,17 +291,127 @@ func (c *Conn) ReadPacket(p *pk.Packet) error {
 	return nil
 }
 
+// WritePacket queues p to be sent, waiting for room in the queue. It fails
+// once a write failed, or right away for a packet the protocol version
+// doesn't have.
 func (c *Conn) WritePacket(p pk.Packet) error {
+	return c.WritePackets(p)
+}
+
+// WritePackets queues packets to be sent one after the other.
+func (c *Conn) WritePackets(packets ...pk.Packet) error {
+	if err := c.writeErr(); err != nil {
+		return err
+	}
+
+	c.pushMu.Lock()
+	defer c.pushMu.Unlock()
+
+	for _, p := range packets {
+		if _, err := c.OutboundID(p.ID); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range packets {
+		err := c.push(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// push queues p, pushMu must be held.
+func (c *Conn) push(p pk.Packet) error {
 	ok := c.send.Push(p)
 	if !ok {
-		return errors.New("queue is full")
+		if err := c.writeErr(); err != nil {
+			return err
+		}
+
+		return stdnet.ErrClosed
 	}
 
+	c.queued.Add(1)
+
+	return nil
+}
+
+// reconfigure acknowledges the start of a configuration, runs configure
+// on the connection in the configuration state and goes back to the play
+// state. Packets sent meanwhile wait for the play state.
+func (c *Conn) reconfigure(configure func(conn *net.Conn) error) error {
+	c.pushMu.Lock()
+	defer c.pushMu.Unlock()
+
+	err := c.push(pk.Marshal(packetid.ServerboundConfigurationAcknowledged))
+	if err != nil {
+		return err
+	}
+
+	// The writer must be done with the play state, and stays idle while
+	// pushMu is held.
+	err = c.Flush(context.Background())
+	if err != nil {
+		return err
+	}
+
+	c.setState(capture.StateConfiguration)
+
+	err = configure(c.Conn)
+	if err != nil {
+		return err
+	}
+
+	c.setState(capture.StatePlay)
+	c.resume <- struct{}{}
+
 	return nil
 }
 
+func (c *Conn) writeErr() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.werr
+}
+
+// Flush waits until the packets queued before it are written to the
+// socket, or dropped by the send queue. It returns the error that stopped
+// the writer if it stops first, or that of ctx.
+func (c *Conn) Flush(ctx context.Context) error {
+	target := c.queued.Load()
+
+	for {
+		c.mu.Lock()
+		written, drained, werr, progress := c.written, c.drained, c.werr, c.progress
+		c.mu.Unlock()
+
+		if written >= target || drained >= target || progress == nil {
+			return nil
+		}
+
+		if werr != nil {
+			return werr
+		}
+
+		select {
+		case <-progress:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Close closes the queues and the connection. A reader waiting for room in
+// the receive queue is let go. Packets still queued are lost, Flush first
+// to send them.
 func (c *Conn) Close() error {
+	c.closeOnce.Do(func() { close(c.done) })
 	c.send.Close()
+	c.recv.Close()
 
 	return c.Conn.Close()
 }
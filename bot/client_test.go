@@ -0,0 +1,818 @@
+package bot_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/auth"
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/bot/bottest"
+	"mcAfkGo/bot/entities"
+	"mcAfkGo/bot/physics"
+	"mcAfkGo/bot/world"
+	"mcAfkGo/chat"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/net/capture"
+	pk "mcAfkGo/net/packet"
+	"mcAfkGo/net/queue"
+	"mcAfkGo/registry"
+)
+
+// sessionAuth is an online authenticator that remembers the server hash it
+// joined with instead of calling the session server.
+type sessionAuth struct {
+	err error
+
+	mu   sync.Mutex
+	hash string
+}
+
+func (a *sessionAuth) Authenticate() (auth.Profile, error) {
+	return auth.Profile{Name: "Steve", UUID: uuid.NewString(), AccessToken: "token"}, nil
+}
+
+func (a *sessionAuth) JoinServer(_ auth.Profile, serverHash string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.hash = serverHash
+
+	return a.err
+}
+
+func (a *sessionAuth) joined() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.hash
+}
+
+// packRecorder keeps the resource packs the server pushed.
+type packRecorder struct {
+	*bot.DefaultConfigHandler
+	packs []bot.ResourcePack
+}
+
+func (r *packRecorder) PushResourcePack(res bot.ResourcePack) {
+	r.packs = append(r.packs, res)
+	r.DefaultConfigHandler.PushResourcePack(res)
+}
+
+func TestJoinServer(t *testing.T) {
+	packID := uuid.New()
+
+	tests := []struct {
+		name  string
+		setup func(srv *bottest.Server, a *sessionAuth)
+		// wantErr is a part of the join error, wantServerErr of the one
+		// the server session ended with.
+		wantErr       string
+		wantServerErr string
+		// wantTransfer is the address of the Transferred event expected.
+		wantTransfer string
+		check        func(t *testing.T, client *bot.Client, a *sessionAuth, packs []bot.ResourcePack)
+	}{
+		{
+			name: "offline",
+		},
+		{
+			name: "compression and encryption",
+			setup: func(srv *bottest.Server, a *sessionAuth) {
+				srv.CompressionThreshold = 0
+				srv.Encryption = true
+				srv.OnlineMode = true
+				srv.HasJoined = func(_, serverHash string) error {
+					if serverHash != a.joined() {
+						return errors.New("server hash mismatch")
+					}
+
+					return nil
+				}
+			},
+			check: func(t *testing.T, _ *bot.Client, a *sessionAuth, _ []bot.ResourcePack) {
+				if a.joined() == "" {
+					t.Error("client did not join the session")
+				}
+			},
+		},
+		{
+			name: "session server refuses",
+			setup: func(srv *bottest.Server, a *sessionAuth) {
+				srv.Encryption = true
+				srv.OnlineMode = true
+				a.err = errors.New("invalid session")
+			},
+			wantErr:       "invalid session",
+			wantServerErr: "login",
+		},
+		{
+			name: "kicked during login",
+			setup: func(srv *bottest.Server, _ *sessionAuth) {
+				srv.Login = func(s *bottest.Session) error {
+					return s.Disconnect(chat.Text("You are banned"))
+				}
+			},
+			wantErr: "You are banned",
+		},
+		{
+			name: "kicked during configuration",
+			setup: func(srv *bottest.Server, _ *sessionAuth) {
+				srv.Configuration = func(s *bottest.Session) error {
+					return s.Disconnect(chat.Text("Server closed"))
+				}
+			},
+			wantErr: "Server closed",
+		},
+		{
+			name: "keep alive during configuration",
+			setup: func(srv *bottest.Server, _ *sessionAuth) {
+				srv.Configuration = func(s *bottest.Session) error {
+					return s.KeepAlive(42)
+				}
+			},
+		},
+		{
+			name: "transfer during configuration",
+			setup: func(srv *bottest.Server, _ *sessionAuth) {
+				srv.Configuration = func(s *bottest.Session) error {
+					return s.Transfer("play.example.com", 25565)
+				}
+			},
+			wantErr:      "transferred to play.example.com:25565",
+			wantTransfer: "play.example.com:25565",
+		},
+		{
+			name: "resource pack prompt",
+			setup: func(srv *bottest.Server, _ *sessionAuth) {
+				srv.Configuration = func(s *bottest.Session) error {
+					prompt := chat.Text("Please accept")
+
+					return s.PushResourcePack(packID, "https://example.com/pack.zip", "abc", true, &prompt)
+				}
+			},
+			check: func(t *testing.T, _ *bot.Client, _ *sessionAuth, packs []bot.ResourcePack) {
+				if len(packs) != 1 {
+					t.Fatalf("got %d resource packs, want 1", len(packs))
+				}
+
+				pack := packs[0]
+				if uuid.UUID(pack.ID) != packID || !pack.Forced || pack.PromptMessage == nil {
+					t.Errorf("resource pack = %+v", pack)
+				}
+			},
+		},
+		{
+			name: "core pack offered",
+			setup: func(srv *bottest.Server, _ *sessionAuth) {
+				srv.Configuration = func(s *bottest.Session) error {
+					return sendRegistries(s, bot.DataPack{Namespace: "minecraft", ID: "core", Version: "1.21"})
+				}
+			},
+			check: func(t *testing.T, client *bot.Client, _ *sessionAuth, _ []bot.ResourcePack) {
+				_, biome := client.Registries.Biome.Get("minecraft:plains")
+				if biome == nil || biome.Temperature == 0 || biome.Effects.SkyColor == 0 {
+					t.Errorf("plains = %+v", biome)
+				}
+
+				_, enchantment := client.Registries.Enchantment.Get("minecraft:sharpness")
+				if enchantment == nil || enchantment.MaxLevel == 0 || len(enchantment.Slots) == 0 {
+					t.Errorf("sharpness = %+v", enchantment)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &sessionAuth{}
+
+			srv := bottest.NewUnstartedServer()
+			srv.Timeout = time.Second
+			if tt.setup != nil {
+				tt.setup(srv, a)
+			}
+
+			srv.Start()
+
+			packs := &packRecorder{DefaultConfigHandler: bot.NewDefaultConfigHandler()}
+
+			client := bot.NewClient()
+			client.Authenticator = a
+			client.ConfigHandler = packs
+
+			transferred := make(chan bot.Transferred, 1)
+			bot.Subscribe(client.Bus, func(e bot.Transferred) { transferred <- e })
+
+			err := client.Authenticate()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = client.JoinServer(srv.Addr)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("join: %v", err)
+			}
+
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("join error = %v, want %q", err, tt.wantErr)
+			}
+
+			if tt.wantTransfer != "" {
+				select {
+				case e := <-transferred:
+					if addr := net.JoinHostPort(e.Host, strconv.Itoa(e.Port)); addr != tt.wantTransfer {
+						t.Errorf("transferred to %s, want %s", addr, tt.wantTransfer)
+					}
+				case <-time.After(time.Second):
+					t.Error("no Transferred event")
+				}
+			}
+
+			_ = client.Close()
+			srv.Wait()
+			srv.Close()
+
+			serverErr := srv.Err()
+			if tt.wantServerErr == "" && serverErr != nil {
+				t.Errorf("server: %v", serverErr)
+			}
+
+			if tt.wantServerErr != "" && (serverErr == nil || !strings.Contains(serverErr.Error(), tt.wantServerErr)) {
+				t.Errorf("server error = %v, want %q", serverErr, tt.wantServerErr)
+			}
+
+			if tt.check != nil {
+				tt.check(t, client, a, packs.packs)
+			}
+		})
+	}
+}
+
+// sendRegistries offers the data pack and sends a biome and an
+// enchantment, without their data if the client accepted the pack.
+func sendRegistries(s *bottest.Session, pack bot.DataPack) error {
+	err := s.WritePacket(pk.Marshal(packetid.ClientboundConfigSelectKnownPacks, pk.Array([]bot.DataPack{pack})))
+	if err != nil {
+		return err
+	}
+
+	p, err := s.Expect(packetid.ServerboundConfigSelectKnownPacks)
+	if err != nil {
+		return err
+	}
+
+	var known []bot.DataPack
+	err = p.Scan(pk.Array(&known))
+	if err != nil {
+		return err
+	}
+
+	hasData := pk.Boolean(len(known) == 0)
+
+	biome := registry.Biome{HasPrecipitation: true, Temperature: 0.8, Downfall: 0.4}
+	biome.Effects.FogColor = 12638463
+	biome.Effects.WaterColor = 4159204
+	biome.Effects.WaterFogColor = 329011
+	biome.Effects.SkyColor = 7907327
+
+	enchantment := struct {
+		Description    chat.Message             `nbt:"description"`
+		SupportedItems string                   `nbt:"supported_items"`
+		Weight         int32                    `nbt:"weight"`
+		MaxLevel       int32                    `nbt:"max_level"`
+		MinCost        registry.EnchantmentCost `nbt:"min_cost"`
+		MaxCost        registry.EnchantmentCost `nbt:"max_cost"`
+		AnvilCost      int32                    `nbt:"anvil_cost"`
+		Slots          []string                 `nbt:"slots"`
+	}{
+		Description:    chat.Message{Translate: "enchantment.minecraft.sharpness"},
+		SupportedItems: "#minecraft:enchantable/sharp_weapon",
+		Weight:         10,
+		MaxLevel:       5,
+		MinCost:        registry.EnchantmentCost{Base: 1, PerLevelAboveFirst: 11},
+		MaxCost:        registry.EnchantmentCost{Base: 21, PerLevelAboveFirst: 11},
+		AnvilCost:      1,
+		Slots:          []string{"mainhand"},
+	}
+
+	entries := []struct {
+		registry, key string
+		data          any
+	}{
+		{"minecraft:worldgen/biome", "minecraft:plains", biome},
+		{"minecraft:enchantment", "minecraft:sharpness", enchantment},
+	}
+
+	for _, e := range entries {
+		fields := []pk.FieldEncoder{pk.Identifier(e.registry), pk.VarInt(1), pk.Identifier(e.key), hasData}
+		if hasData {
+			fields = append(fields, pk.NBTField{V: e.data})
+		}
+
+		err = s.WritePacket(pk.Marshal(packetid.ClientboundConfigRegistryData, fields...))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestClientClose(t *testing.T) {
+	// kick kicks the client and fails the session if the client doesn't
+	// close the connection by itself.
+	kick := func(s *bottest.Session) error {
+		err := s.Disconnect(chat.Text("Go away"))
+		if err != nil {
+			return err
+		}
+
+		var p pk.Packet
+		for err == nil {
+			err = s.ReadPacket(&p)
+		}
+
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return errors.New("connection left open")
+		}
+
+		return nil
+	}
+
+	tests := []struct {
+		name  string
+		setup func(srv *bottest.Server)
+	}{
+		{name: "not joined"},
+		{name: "kicked during login", setup: func(srv *bottest.Server) { srv.Login = kick }},
+		{name: "kicked during configuration", setup: func(srv *bottest.Server) { srv.Configuration = kick }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := bot.NewClient()
+			client.Auth.Name = "Steve"
+
+			connected := make(chan bot.Connected, 1)
+			bot.Subscribe(client.Bus, func(e bot.Connected) { connected <- e })
+
+			if tt.setup != nil {
+				srv := bottest.NewUnstartedServer()
+				srv.Timeout = time.Second
+				tt.setup(srv)
+				srv.Start()
+
+				if err := client.JoinServer(srv.Addr); err == nil {
+					t.Fatal("joined")
+				}
+
+				srv.Wait()
+				srv.Close()
+
+				if err := srv.Err(); err != nil {
+					t.Errorf("server: %v", err)
+				}
+			}
+
+			// Closing a client without a connection, or with the one the
+			// failed join closed, stops its bus.
+			_ = client.Close()
+
+			bot.Publish(client.Bus, bot.Connected{})
+			select {
+			case <-connected:
+				t.Error("event delivered after Close")
+			case <-time.After(50 * time.Millisecond):
+			}
+		})
+	}
+}
+
+// TestConstructorErrors checks that the constructors return the error of
+// adding their listeners, here to a client without handler slots.
+func TestConstructorErrors(t *testing.T) {
+	client := &bot.Client{Bus: bot.NewBus()}
+	defer client.Bus.Close()
+
+	p := &basic.Player{}
+
+	tests := []struct {
+		name string
+		new  func() error
+	}{
+		{name: "player", new: func() error {
+			_, err := basic.NewPlayer(client, basic.DefaultSettings, basic.EventsListener{})
+			return err
+		}},
+		{name: "world", new: func() error {
+			_, err := world.NewWorld(client, p)
+			return err
+		}},
+		{name: "tracker", new: func() error {
+			_, err := entities.NewTracker(client, p, entities.EventsListener{})
+			return err
+		}},
+		{name: "physics", new: func() error {
+			_, err := physics.NewPhysics(client, p, nil, nil)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.new(); !errors.Is(err, bot.ErrInvalidPacketID) {
+				t.Errorf("error = %v, want %v", err, bot.ErrInvalidPacketID)
+			}
+		})
+	}
+}
+
+func TestHandleGame(t *testing.T) {
+	tests := []struct {
+		name string
+		// player attaches a basic.Player, which answers keep alives.
+		player        bool
+		play          func(s *bottest.Session) error
+		wantKick      string
+		wantServerErr string
+	}{
+		{
+			name: "kicked",
+			play: func(s *bottest.Session) error {
+				return s.Disconnect(chat.Text("Flying is not enabled"))
+			},
+			wantKick: "Flying is not enabled",
+		},
+		{
+			name:   "keep alive",
+			player: true,
+			play: func(s *bottest.Session) error {
+				for id := range int64(3) {
+					if err := s.KeepAlive(id); err != nil {
+						return err
+					}
+				}
+
+				return s.Disconnect(chat.Text("done"))
+			},
+			wantKick: "done",
+		},
+		{
+			name:   "reconfiguration",
+			player: true,
+			play: func(s *bottest.Session) error {
+				err := s.KeepAlive(1)
+				if err != nil {
+					return err
+				}
+
+				err = s.Reconfigure(func(s *bottest.Session) error { return s.KeepAlive(2) })
+				if err != nil {
+					return err
+				}
+
+				err = s.KeepAlive(3)
+				if err != nil {
+					return err
+				}
+
+				return s.Disconnect(chat.Text("done"))
+			},
+			wantKick: "done",
+		},
+		{
+			name: "keep alive timeout",
+			play: func(s *bottest.Session) error {
+				return s.KeepAlive(1)
+			},
+			wantServerErr: "ServerboundKeepAlive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := bottest.NewUnstartedServer()
+			srv.Timeout = 300 * time.Millisecond
+			srv.Play = tt.play
+			srv.Start()
+
+			client := bot.NewClient()
+			client.Auth.Name = "Steve"
+
+			if tt.player {
+				_, err := basic.NewPlayer(client, basic.DefaultSettings, basic.EventsListener{})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			kicked := make(chan chat.Message, 1)
+			bot.Subscribe(client.Bus, func(e bot.Kicked) { kicked <- e.Reason })
+
+			captureFile := filepath.Join(t.TempDir(), "session.mccap")
+			recorder, err := capture.NewRecorder(captureFile, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = client.JoinServerWithOptions(srv.Addr, bot.JoinOptions{Capture: recorder})
+			if err != nil {
+				t.Fatalf("join: %v", err)
+			}
+
+			done := make(chan error, 1)
+			go func() { done <- client.HandleGame() }()
+
+			select {
+			case err = <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("HandleGame did not return after the session ended")
+			}
+
+			if err == nil {
+				t.Error("HandleGame returned nil")
+			}
+
+			if tt.wantKick != "" {
+				select {
+				case reason := <-kicked:
+					if reason.ClearString() != tt.wantKick {
+						t.Errorf("kicked for %q, want %q", reason.ClearString(), tt.wantKick)
+					}
+				case <-time.After(time.Second):
+					t.Error("no Kicked event")
+				}
+			}
+
+			_ = client.Close()
+			srv.Wait()
+			srv.Close()
+
+			serverErr := srv.Err()
+			if tt.wantServerErr == "" && serverErr != nil {
+				t.Errorf("server: %v", serverErr)
+			}
+
+			if tt.wantServerErr != "" && (serverErr == nil || !strings.Contains(serverErr.Error(), tt.wantServerErr)) {
+				t.Errorf("server error = %v, want %q", serverErr, tt.wantServerErr)
+			}
+
+			if err := recorder.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			checkKeepAliveStates(t, captureFile)
+		})
+	}
+}
+
+// droppingQueue is a send queue that drops the packets with the ID drop.
+type droppingQueue struct {
+	*queue.RingQueue[pk.Packet]
+	drop packetid.ServerboundPacketID
+}
+
+func (q droppingQueue) Push(p pk.Packet) bool {
+	if p.ID == int32(q.drop) {
+		return true
+	}
+
+	return q.RingQueue.Push(p)
+}
+
+func TestHandlerErrors(t *testing.T) {
+	// A bundle of packets 1 to 5 and packet 6 after it, packet 2 is 40
+	// bytes long.
+	srv := bottest.NewServer(func(s *bottest.Session) error {
+		packets := []pk.Packet{{ID: int32(packetid.BundleDelimiter)}}
+		for i := range byte(6) {
+			data := []byte{i + 1}
+			if i+1 == 2 {
+				data = bytes.Repeat(data, 40)
+			}
+
+			packets = append(packets, pk.Packet{ID: int32(packetid.ClientboundSetTime), Data: data})
+			if i+1 == 5 {
+				packets = append(packets, pk.Packet{ID: int32(packetid.BundleDelimiter)})
+			}
+		}
+
+		for _, p := range packets {
+			if err := s.WritePacket(p); err != nil {
+				return err
+			}
+		}
+
+		return s.Disconnect(chat.Text("done"))
+	})
+	defer srv.Close()
+
+	client := bot.NewClient()
+	client.Auth.Name = "Steve"
+
+	// Packet 2 fails and packet 4 panics.
+	var handled []byte
+	_, err := client.Events.AddListener(bot.PacketHandler{
+		ID: packetid.ClientboundSetTime,
+		F: func(p pk.Packet) error {
+			handled = append(handled, p.Data[0])
+
+			switch p.Data[0] {
+			case 2:
+				return errors.New("bad packet")
+			case 4:
+				panic("boom")
+			}
+
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.JoinServer(srv.Addr)
+	if err != nil {
+		t.Fatalf("join: %v", err)
+	}
+	defer client.Close()
+
+	// The errors HandleGame returns until the session ends, called again
+	// after each like the skip policy does.
+	var errs []bot.PacketHandlerError
+	for {
+		err = client.HandleGame()
+
+		herr := new(bot.PacketHandlerError)
+		if !errors.As(err, herr) {
+			break
+		}
+
+		errs = append(errs, *herr)
+	}
+
+	if !bytes.Equal(handled, []byte{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("handled packets %v, want 1 to 6", handled)
+	}
+
+	if len(errs) != 2 {
+		t.Fatalf("got %d handler errors, want 2: %v", len(errs), errs)
+	}
+
+	if e := errs[0]; e.Length != 40 || !bytes.Equal(e.Prefix, bytes.Repeat([]byte{2}, 32)) || !strings.HasSuffix(e.Error(), "...) error: bad packet") {
+		t.Errorf("first error %v, length %d, prefix %x", e, e.Length, e.Prefix)
+	}
+
+	perr := new(bot.PanicError)
+	if e := errs[1]; !errors.As(e, perr) || perr.Value != "boom" || len(perr.Stack) == 0 || e.Length != 1 || strings.Contains(e.Error(), "...") {
+		t.Errorf("second error %v, length %d, panic %+v", e, e.Length, perr.Value)
+	}
+}
+
+func TestReceiveQueueEntityOrder(t *testing.T) {
+	const id = pk.VarInt(7)
+
+	moveEntity := pk.Marshal(packetid.ClientboundMoveEntityPos, id, pk.Short(4096), pk.Short(0), pk.Short(0), pk.Boolean(true))
+	packets := []pk.Packet{
+		pk.Marshal(packetid.ClientboundRemoveEntities, pk.Array([]pk.VarInt{3, id})),
+		pk.Marshal(packetid.ClientboundAddEntity, id),
+		moveEntity,
+	}
+
+	// Under pressure from the sixth packet, the move.
+	q := bot.NewReceiveQueue(8)
+	for range 5 {
+		q.Push(pk.Marshal(packetid.ClientboundSetTime))
+	}
+
+	q.Push(moveEntity)
+	for _, p := range packets {
+		q.Push(p)
+	}
+
+	// The move before the entity was removed is dropped, the one of the
+	// new entity stays after it was added.
+	var got []pk.Packet
+	for {
+		p, ok := q.TryPull()
+		if !ok {
+			break
+		}
+
+		if p.ID != int32(packetid.ClientboundSetTime) {
+			got = append(got, p)
+		}
+	}
+
+	if len(got) != len(packets) {
+		t.Fatalf("got %d entity packets, want %d", len(got), len(packets))
+	}
+
+	for i, p := range got {
+		if p.ID != packets[i].ID {
+			t.Errorf("packet %d is %v, want %v", i, packetid.ClientboundPacketID(p.ID), packetid.ClientboundPacketID(packets[i].ID))
+		}
+	}
+
+	if stats := q.Stats(); stats.Dropped != 1 || stats.Merged != 0 {
+		t.Errorf("dropped %d, merged %d, want 1, 0", stats.Dropped, stats.Merged)
+	}
+}
+
+func TestFlushDropped(t *testing.T) {
+	srv := bottest.NewServer(func(s *bottest.Session) error {
+		_, err := s.Expect(packetid.ServerboundMovePlayerPos)
+
+		return err
+	})
+
+	client := bot.NewClient()
+	client.Auth.Name = "Steve"
+
+	err := client.JoinServerWithOptions(srv.Addr, bot.JoinOptions{
+		QueueWrite: droppingQueue{bot.NewSendQueue(8), packetid.ServerboundMovePlayerStatusOnly},
+	})
+	if err != nil {
+		t.Fatalf("join: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	err = client.Conn.WritePacket(pk.Marshal(packetid.ServerboundMovePlayerStatusOnly, pk.Boolean(true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Conn.Flush(ctx); err != nil {
+		t.Fatalf("flush after a dropped packet: %v", err)
+	}
+
+	err = client.Conn.WritePacket(pk.Marshal(
+		packetid.ServerboundMovePlayerPos,
+		pk.Double(0), pk.Double(64), pk.Double(0), pk.Boolean(true),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Conn.Flush(ctx); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	srv.Wait()
+	_ = client.Close()
+	srv.Close()
+
+	if err := srv.Err(); err != nil {
+		t.Errorf("server: %v", err)
+	}
+}
+
+// checkKeepAliveStates checks that the capture has every keep alive answer
+// of the client in the state it was sent in.
+func checkKeepAliveStates(t *testing.T, path string) {
+	t.Helper()
+
+	records, err := capture.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rec := range records {
+		if rec.Direction != capture.Outbound {
+			continue
+		}
+
+		var want capture.State
+		switch rec.Packet.ID {
+		case int32(packetid.ServerboundKeepAlive):
+			want = capture.StatePlay
+		case int32(packetid.ServerboundConfigKeepAlive):
+			want = capture.StateConfiguration
+		default:
+			continue
+		}
+
+		var id pk.Long
+		if err := rec.Packet.Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+
+		if rec.State != want {
+			t.Errorf("keep alive %d captured in the %v state, want %v", id, rec.State, want)
+		}
+	}
+}
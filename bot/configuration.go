@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	stdnet "net"
+	"slices"
+	"strconv"
 
 	"mcAfkGo/chat"
 	"mcAfkGo/data/packetid"
 	"mcAfkGo/nbt"
 	"mcAfkGo/net"
 	pk "mcAfkGo/net/packet"
+	"mcAfkGo/registry"
 )
 
 type ConfigHandler interface {
@@ -43,6 +47,16 @@ func (l ConfigErr) Unwrap() error {
 	return l.Err
 }
 
+// TransferError ends a join the server transferred to another server.
+type TransferError struct {
+	Host string
+	Port int
+}
+
+func (t TransferError) Error() string {
+	return "bot: transferred to " + stdnet.JoinHostPort(t.Host, strconv.Itoa(t.Port))
+}
+
 func (c *Client) joinConfiguration(conn *net.Conn) error {
 	for {
 		var p pk.Packet
@@ -206,6 +220,10 @@ func (c *Client) joinConfiguration(conn *net.Conn) error {
 				return ConfigErr{"transfer", err}
 			}
 
+			Publish(c.Bus, Transferred{Host: string(host), Port: int(port)})
+
+			return TransferError{Host: string(host), Port: int(port)}
+
 		case packetid.ClientboundConfigUpdateEnabledFeatures:
 			features := []pk.Identifier{}
 			err := p.Scan(pk.Array(&features))
@@ -256,6 +274,13 @@ func (c *Client) joinConfiguration(conn *net.Conn) error {
 				return ConfigErr{ErrStage, err}
 			}
 			knownPacks := c.ConfigHandler.SelectDataPacks(packs)
+			if slices.ContainsFunc(knownPacks, DataPack.isCore) {
+				err = c.Registries.UseCorePack()
+				if err != nil {
+					return ConfigErr{ErrStage, err}
+				}
+			}
+
 			err = conn.WritePacket(pk.Marshal(
 				packetid.ServerboundConfigSelectKnownPacks,
 				pk.Array(knownPacks),
@@ -297,6 +322,10 @@ type DataPack struct {
 	Version   string
 }
 
+func (d DataPack) isCore() bool {
+	return registry.IsCorePack(d.Namespace, d.ID, d.Version)
+}
+
 func (d DataPack) WriteTo(w io.Writer) (n int64, err error) {
 	n, err = pk.String(d.Namespace).WriteTo(w)
 	if err != nil {
@@ -358,8 +387,17 @@ func (d *DefaultConfigHandler) PopAllResourcePack() {
 	d.resourcesPack = d.resourcesPack[:0]
 }
 
-func (d *DefaultConfigHandler) SelectDataPacks(_ []DataPack) []DataPack {
-	return []DataPack{}
+// SelectDataPacks accepts the vanilla core pack if the data of every
+// registry the bot keeps is embedded, so the server doesn't have to send it.
+func (d *DefaultConfigHandler) SelectDataPacks(packs []DataPack) []DataPack {
+	known := []DataPack{}
+	for _, pack := range packs {
+		if pack.isCore() {
+			known = append(known, pack)
+		}
+	}
+
+	return known
 }
 
 type idleRegistryDecoder struct{}
@@ -0,0 +1,521 @@
+// Package entities tracks the entities the server shows the player, and
+// reports hostile mobs coming close.
+package entities
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/data/entity"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/maths"
+	"mcAfkGo/net/metadata"
+	pk "mcAfkGo/net/packet"
+)
+
+type Entity struct {
+	ID       int32
+	UUID     uuid.UUID
+	Type     int32
+	TypeName string
+
+	Position maths.Vec3
+	// Velocity is in blocks per tick.
+	Velocity maths.Vec3
+	Yaw      float32
+	Pitch    float32
+	HeadYaw  float32
+	OnGround bool
+
+	// Data is the type specific value of the spawn packet, e.g. the block
+	// state of a falling block.
+	Data int32
+
+	// Metadata is the entity data, with the values of all updates so far.
+	// Field names the indices.
+	Metadata metadata.Metadata
+}
+
+func (e *Entity) IsHostile() bool {
+	return entity.IsHostile(e.Type)
+}
+
+// Field returns the value of the named entity data field, false if the
+// type has no such field or the server hasn't sent it.
+func (e *Entity) Field(name string) (metadata.Value, bool) {
+	i := entity.FieldIndex(e.Type, name)
+	if i < 0 {
+		return metadata.Value{}, false
+	}
+
+	v, ok := e.Metadata[uint8(i)]
+
+	return v, ok
+}
+
+func (e *Entity) flags() int8 {
+	v, _ := metadata.Get[pk.Byte](e.Metadata, entity.FieldSharedFlags)
+
+	return int8(v)
+}
+
+func (e *Entity) IsSneaking() bool {
+	return e.flags()&entity.FlagCrouching != 0
+}
+
+func (e *Entity) IsSprinting() bool {
+	return e.flags()&entity.FlagSprinting != 0
+}
+
+func (e *Entity) IsInvisible() bool {
+	return e.flags()&entity.FlagInvisible != 0
+}
+
+// Pose returns one of the entity.Pose constants.
+func (e *Entity) Pose() int32 {
+	v, _ := metadata.Get[pk.VarInt](e.Metadata, entity.FieldPose)
+
+	return int32(v)
+}
+
+// Item returns the item stack of items, item frames and displays and the
+// thrown items, false for other entities.
+func (e *Entity) Item() (pk.Slot, bool) {
+	for _, name := range []string{"item", "item_stack"} {
+		if v, ok := e.Field(name); ok {
+			slot, ok := v.V.(pk.Slot)
+
+			return slot, ok
+		}
+	}
+
+	return pk.Slot{}, false
+}
+
+// EventsListener is told about hostile mobs near the player. A mob is
+// near when it is within Radius blocks; it leaves once it is further than
+// that or gone.
+type EventsListener struct {
+	Radius       float64
+	HostileNear  func(e Entity, distance float64) error
+	HostileLeave func(e Entity) error
+}
+
+type Tracker struct {
+	c *bot.Client
+	p *basic.Player
+
+	events EventsListener
+
+	mu       sync.RWMutex
+	entities map[int32]*Entity
+	// self is the player's position, which distances are measured from.
+	self maths.Vec3
+	near map[int32]bool
+}
+
+func NewTracker(c *bot.Client, p *basic.Player, events EventsListener) (*Tracker, error) {
+	t := &Tracker{
+		c:        c,
+		p:        p,
+		events:   events,
+		entities: make(map[int32]*Entity),
+		near:     make(map[int32]bool),
+	}
+
+	// The login, respawn and position handlers run after the player's.
+	_, err := c.Events.AddListener(
+		bot.PacketHandler{Priority: -1, ID: packetid.ClientboundLogin, F: t.handleReset},
+		bot.PacketHandler{Priority: -1, ID: packetid.ClientboundRespawn, F: t.handleReset},
+		bot.PacketHandler{Priority: -1, ID: packetid.ClientboundPlayerPosition, F: t.handlePlayerPosition},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundAddEntity, F: t.handleAddEntity},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundRemoveEntities, F: t.handleRemoveEntities},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundMoveEntityPos, F: t.handleMoveEntityPos},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundMoveEntityPosRot, F: t.handleMoveEntityPosRot},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundMoveEntityRot, F: t.handleMoveEntityRot},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundTeleportEntity, F: t.handleTeleportEntity},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundRotateHead, F: t.handleRotateHead},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundSetEntityMotion, F: t.handleSetEntityMotion},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundSetEntityData, F: t.handleSetEntityData},
+	)
+	if err != nil {
+		return nil, Error{err}
+	}
+
+	return t, nil
+}
+
+// Entity returns a copy of the entity with the given ID.
+func (t *Tracker) Entity(id int32) (Entity, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	e, ok := t.entities[id]
+	if !ok {
+		return Entity{}, false
+	}
+
+	return *e, true
+}
+
+// Entities returns copies of all entities, closest first.
+func (t *Tracker) Entities() []Entity {
+	return t.Within(math.Inf(1), nil)
+}
+
+// Within returns the entities within radius blocks of the player that
+// match filter, closest first. A nil filter matches all.
+func (t *Tracker) Within(radius float64, filter func(*Entity) bool) []Entity {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var found []Entity
+	for _, e := range t.entities {
+		if e.Position.DistanceTo(t.self) <= radius && (filter == nil || filter(e)) {
+			found = append(found, *e)
+		}
+	}
+
+	slices.SortFunc(found, func(a, b Entity) int {
+		da, db := a.Position.DistanceTo(t.self), b.Position.DistanceTo(t.self)
+		if da < db {
+			return -1
+		} else if da > db {
+			return 1
+		}
+
+		return 0
+	})
+
+	return found
+}
+
+// Nearest returns the entity closest to the player that matches filter.
+func (t *Tracker) Nearest(filter func(*Entity) bool) (Entity, bool) {
+	found := t.Within(math.Inf(1), filter)
+	if len(found) == 0 {
+		return Entity{}, false
+	}
+
+	return found[0], true
+}
+
+// SetPosition moves the player, e.g. each tick of the physics, and reports
+// the hostile mobs that came within or left the radius.
+func (t *Tracker) SetPosition(pos maths.Vec3) error {
+	t.mu.Lock()
+	t.self = pos
+	t.mu.Unlock()
+
+	return t.checkNear()
+}
+
+// Reset forgets every entity, the server adds them again after the next
+// login or respawn.
+func (t *Tracker) Reset() {
+	t.mu.Lock()
+	clear(t.entities)
+	clear(t.near)
+	t.mu.Unlock()
+}
+
+func (t *Tracker) handleReset(pk.Packet) error {
+	t.mu.Lock()
+	clear(t.entities)
+	clear(t.near)
+	t.self = t.p.Position
+	t.mu.Unlock()
+
+	return nil
+}
+
+func (t *Tracker) handlePlayerPosition(pk.Packet) error {
+	return t.SetPosition(t.p.Position)
+}
+
+func (t *Tracker) handleAddEntity(p pk.Packet) error {
+	var (
+		id, typ, data  pk.VarInt
+		id2            pk.UUID
+		x, y, z        pk.Double
+		pitch, yaw, hy pk.Angle
+		vx, vy, vz     pk.Short
+	)
+
+	err := p.Scan(&id, &id2, &typ, &x, &y, &z, &pitch, &yaw, &hy, &data, &vx, &vy, &vz)
+	if err != nil {
+		return Error{err}
+	}
+
+	e := &Entity{
+		ID:       int32(id),
+		UUID:     uuid.UUID(id2),
+		Type:     int32(typ),
+		TypeName: entity.TypeName(int32(typ)),
+		Position: maths.Vec3{X: float64(x), Y: float64(y), Z: float64(z)},
+		Velocity: velocity(vx, vy, vz),
+		Yaw:      angle(yaw),
+		Pitch:    angle(pitch),
+		HeadYaw:  angle(hy),
+		Data:     int32(data),
+	}
+
+	t.mu.Lock()
+	t.entities[e.ID] = e
+	t.mu.Unlock()
+
+	return t.checkNear(e.ID)
+}
+
+func (t *Tracker) handleRemoveEntities(p pk.Packet) error {
+	var ids []pk.VarInt
+	err := p.Scan(pk.Array(&ids))
+	if err != nil {
+		return Error{err}
+	}
+
+	var left []Entity
+
+	t.mu.Lock()
+	for _, id := range ids {
+		if e, ok := t.entities[int32(id)]; ok && t.near[e.ID] {
+			left = append(left, *e)
+			delete(t.near, e.ID)
+		}
+
+		delete(t.entities, int32(id))
+	}
+	t.mu.Unlock()
+
+	if t.events.HostileLeave == nil {
+		return nil
+	}
+
+	for _, e := range left {
+		err = t.events.HostileLeave(e)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Tracker) handleMoveEntityPos(p pk.Packet) error {
+	var id pk.VarInt
+	var dx, dy, dz pk.Short
+	var onGround pk.Boolean
+
+	err := p.Scan(&id, &dx, &dy, &dz, &onGround)
+	if err != nil {
+		return Error{err}
+	}
+
+	t.update(int32(id), func(e *Entity) {
+		e.Position = e.Position.Add(delta(dx, dy, dz))
+		e.OnGround = bool(onGround)
+	})
+
+	return t.checkNear(int32(id))
+}
+
+func (t *Tracker) handleMoveEntityPosRot(p pk.Packet) error {
+	var id pk.VarInt
+	var dx, dy, dz pk.Short
+	var yaw, pitch pk.Angle
+	var onGround pk.Boolean
+
+	err := p.Scan(&id, &dx, &dy, &dz, &yaw, &pitch, &onGround)
+	if err != nil {
+		return Error{err}
+	}
+
+	t.update(int32(id), func(e *Entity) {
+		e.Position = e.Position.Add(delta(dx, dy, dz))
+		e.Yaw, e.Pitch = angle(yaw), angle(pitch)
+		e.OnGround = bool(onGround)
+	})
+
+	return t.checkNear(int32(id))
+}
+
+func (t *Tracker) handleMoveEntityRot(p pk.Packet) error {
+	var id pk.VarInt
+	var yaw, pitch pk.Angle
+	var onGround pk.Boolean
+
+	err := p.Scan(&id, &yaw, &pitch, &onGround)
+	if err != nil {
+		return Error{err}
+	}
+
+	t.update(int32(id), func(e *Entity) {
+		e.Yaw, e.Pitch = angle(yaw), angle(pitch)
+		e.OnGround = bool(onGround)
+	})
+
+	return nil
+}
+
+func (t *Tracker) handleTeleportEntity(p pk.Packet) error {
+	var id pk.VarInt
+	var x, y, z pk.Double
+	var yaw, pitch pk.Angle
+	var onGround pk.Boolean
+
+	err := p.Scan(&id, &x, &y, &z, &yaw, &pitch, &onGround)
+	if err != nil {
+		return Error{err}
+	}
+
+	t.update(int32(id), func(e *Entity) {
+		e.Position = maths.Vec3{X: float64(x), Y: float64(y), Z: float64(z)}
+		e.Yaw, e.Pitch = angle(yaw), angle(pitch)
+		e.OnGround = bool(onGround)
+	})
+
+	return t.checkNear(int32(id))
+}
+
+func (t *Tracker) handleRotateHead(p pk.Packet) error {
+	var id pk.VarInt
+	var yaw pk.Angle
+
+	err := p.Scan(&id, &yaw)
+	if err != nil {
+		return Error{err}
+	}
+
+	t.update(int32(id), func(e *Entity) { e.HeadYaw = angle(yaw) })
+
+	return nil
+}
+
+func (t *Tracker) handleSetEntityMotion(p pk.Packet) error {
+	var id pk.VarInt
+	var vx, vy, vz pk.Short
+
+	err := p.Scan(&id, &vx, &vy, &vz)
+	if err != nil {
+		return Error{err}
+	}
+
+	t.update(int32(id), func(e *Entity) { e.Velocity = velocity(vx, vy, vz) })
+
+	return nil
+}
+
+func (t *Tracker) handleSetEntityData(p pk.Packet) error {
+	var id pk.VarInt
+	var changed metadata.Metadata
+
+	err := p.Scan(&id, &changed)
+	if err != nil {
+		return Error{err}
+	}
+
+	// Copies of the entity handed out keep the values they had.
+	t.update(int32(id), func(e *Entity) {
+		m := maps.Clone(e.Metadata)
+		if m == nil {
+			m = changed
+		} else {
+			maps.Copy(m, changed)
+		}
+
+		e.Metadata = m
+	})
+
+	return nil
+}
+
+func (t *Tracker) update(id int32, f func(e *Entity)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if e, ok := t.entities[id]; ok {
+		f(e)
+	}
+}
+
+// checkNear fires the events of the hostile mobs among ids that came
+// within or left the radius, of all mobs if ids is empty.
+func (t *Tracker) checkNear(ids ...int32) error {
+	if t.events.Radius <= 0 {
+		return nil
+	}
+
+	type change struct {
+		e        Entity
+		distance float64
+		near     bool
+	}
+
+	var changes []change
+
+	t.mu.Lock()
+	for id, e := range t.entities {
+		if !e.IsHostile() || len(ids) > 0 && !slices.Contains(ids, id) {
+			continue
+		}
+
+		d := e.Position.DistanceTo(t.self)
+		if near := d <= t.events.Radius; near != t.near[id] {
+			changes = append(changes, change{*e, d, near})
+			if near {
+				t.near[id] = true
+			} else {
+				delete(t.near, id)
+			}
+		}
+	}
+	t.mu.Unlock()
+
+	for _, c := range changes {
+		var err error
+		if c.near && t.events.HostileNear != nil {
+			err = t.events.HostileNear(c.e, c.distance)
+		} else if !c.near && t.events.HostileLeave != nil {
+			err = t.events.HostileLeave(c.e)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delta converts a relative move, in 1/4096 blocks.
+func delta(dx, dy, dz pk.Short) maths.Vec3 {
+	return maths.Vec3{X: float64(dx) / 4096, Y: float64(dy) / 4096, Z: float64(dz) / 4096}
+}
+
+// velocity converts a velocity in 1/8000 blocks per tick.
+func velocity(vx, vy, vz pk.Short) maths.Vec3 {
+	return maths.Vec3{X: float64(vx) / 8000, Y: float64(vy) / 8000, Z: float64(vz) / 8000}
+}
+
+// angle converts an angle in 1/256 turns to degrees.
+func angle(a pk.Angle) float32 {
+	return float32(uint8(a)) * 360 / 256
+}
+
+type Error struct {
+	Err error
+}
+
+func (e Error) Error() string {
+	return "bot/entities: " + e.Err.Error()
+}
+
+func (e Error) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,172 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/data/entity"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/maths"
+	pk "mcAfkGo/net/packet"
+)
+
+// A step changes the tracker as a packet or a physics tick would.
+type step func(tr *Tracker) error
+
+func add(id int32, typ string, x float64) step {
+	return func(tr *Tracker) error {
+		return tr.handleAddEntity(pk.Marshal(packetid.ClientboundAddEntity,
+			pk.VarInt(id), pk.UUID(uuid.New()), pk.VarInt(entity.TypeID(typ)),
+			pk.Double(x), pk.Double(0), pk.Double(0),
+			pk.Angle(0), pk.Angle(0), pk.Angle(0),
+			pk.VarInt(0), pk.Short(0), pk.Short(0), pk.Short(0),
+		))
+	}
+}
+
+func move(id int32, dx float64) step {
+	return func(tr *Tracker) error {
+		return tr.handleMoveEntityPos(pk.Marshal(packetid.ClientboundMoveEntityPos,
+			pk.VarInt(id), pk.Short(dx*4096), pk.Short(0), pk.Short(0), pk.Boolean(true),
+		))
+	}
+}
+
+func teleport(id int32, x float64) step {
+	return func(tr *Tracker) error {
+		return tr.handleTeleportEntity(pk.Marshal(packetid.ClientboundTeleportEntity,
+			pk.VarInt(id), pk.Double(x), pk.Double(0), pk.Double(0), pk.Angle(0), pk.Angle(0), pk.Boolean(true),
+		))
+	}
+}
+
+func remove(ids ...int32) step {
+	return func(tr *Tracker) error {
+		var a []pk.VarInt
+		for _, id := range ids {
+			a = append(a, pk.VarInt(id))
+		}
+
+		return tr.handleRemoveEntities(pk.Marshal(packetid.ClientboundRemoveEntities, pk.Array(a)))
+	}
+}
+
+func moveSelf(x float64) step {
+	return func(tr *Tracker) error {
+		return tr.SetPosition(maths.Vec3{X: x})
+	}
+}
+
+func TestTracker(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []step
+		// want are the x of the entities by ID, within the IDs of those
+		// within 10 blocks of the player, closest first.
+		want   map[int32]float64
+		within []int32
+		// events are the HostileNear and HostileLeave calls, in order.
+		events []string
+	}{
+		{
+			name:   "added",
+			steps:  []step{add(1, "pig", 1), add(2, "zombie", 20), add(3, "zombie", -4)},
+			want:   map[int32]float64{1: 1, 2: 20, 3: -4},
+			within: []int32{1, 3},
+			events: []string{"near 3 4.0"},
+		},
+		{
+			name:   "moved",
+			steps:  []step{add(1, "zombie", 20), move(1, -7), move(1, -4.5), move(1, 0.5), move(2, 1)},
+			want:   map[int32]float64{1: 9},
+			within: []int32{1},
+			events: []string{"near 1 8.5"},
+		},
+		{
+			name:   "teleported",
+			steps:  []step{add(1, "zombie", 5), teleport(1, 30), teleport(1, -10)},
+			want:   map[int32]float64{1: -10},
+			within: []int32{1},
+			events: []string{"near 1 5.0", "leave 1", "near 1 10.0"},
+		},
+		{
+			name:   "removed",
+			steps:  []step{add(1, "zombie", 5), add(2, "zombie", 50), add(3, "pig", 1), remove(1, 2, 3, 4)},
+			want:   map[int32]float64{},
+			events: []string{"near 1 5.0", "leave 1"},
+		},
+		{
+			name:   "player moved",
+			steps:  []step{add(1, "zombie", 20), add(2, "pig", 20), moveSelf(15), moveSelf(14), moveSelf(0)},
+			want:   map[int32]float64{1: 20, 2: 20},
+			events: []string{"near 1 5.0", "leave 1"},
+		},
+		{
+			name:   "player moved to a mob",
+			steps:  []step{add(1, "zombie", 20), add(2, "zombie", 35), moveSelf(19), moveSelf(26)},
+			want:   map[int32]float64{1: 20, 2: 35},
+			within: []int32{1, 2},
+			events: []string{"near 1 1.0", "near 2 9.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []string
+
+			c := bot.NewClient()
+			p, err := basic.NewPlayer(c, basic.DefaultSettings, basic.EventsListener{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tr, err := NewTracker(c, p, EventsListener{
+				Radius: 10,
+				HostileNear: func(e Entity, distance float64) error {
+					events = append(events, fmt.Sprintf("near %d %.1f", e.ID, distance))
+					return nil
+				},
+				HostileLeave: func(e Entity) error {
+					events = append(events, fmt.Sprintf("leave %d", e.ID))
+					return nil
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, s := range tt.steps {
+				if err := s(tr); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got := make(map[int32]float64)
+			for _, e := range tr.Entities() {
+				got[e.ID] = e.Position.X
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("entities at %v, want %v", got, tt.want)
+			}
+
+			var within []int32
+			for _, e := range tr.Within(10, nil) {
+				within = append(within, e.ID)
+			}
+
+			if !slices.Equal(within, tt.within) {
+				t.Errorf("Within = %v, want %v", within, tt.within)
+			}
+
+			if !slices.Equal(events, tt.events) {
+				t.Errorf("events = %q, want %q", events, tt.events)
+			}
+		})
+	}
+}
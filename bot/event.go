@@ -1,42 +1,213 @@
 package bot
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"sort"
-	"strconv"
+	"sync"
 
 	"mcAfkGo/data/packetid"
 	pk "mcAfkGo/net/packet"
 )
 
+// Events dispatches the packets of the play state to the handlers added
+// for their ID and to the generic ones, which see every packet. Listeners
+// may be added and removed from any goroutine, also from a handler.
 type Events struct {
-	generic  []PacketHandler   `desc:"for every packet"`
-	handlers [][]PacketHandler `desc:"for specific packet id only"`
-}
+	// OnError gets the errors of handlers with the ErrorContinue policy.
+	OnError func(err PacketHandlerError)
 
-func (e *Events) AddListener(listeners ...PacketHandler) {
-	for _, listener := range listeners {
-		if listener.ID < 0 || int(listener.ID) >= len(e.handlers) {
-			panic("Invalid packet ID (" + strconv.Itoa(int(listener.ID)) + ")")
-		}
+	mu          sync.RWMutex
+	lastID      ListenerID
+	middlewares []Middleware
+	// The listeners are sorted by priority and replaced, never changed in
+	// place, so the dispatcher can use them without holding mu.
+	generic  []listener   `desc:"for every packet"`
+	handlers [][]listener `desc:"for specific packet id only"`
+}
 
-		s := e.handlers[listener.ID]
-		if s == nil {
-			e.handlers[listener.ID] = []PacketHandler{listener}
-		} else {
-			e.handlers[listener.ID] = append(s, listener)
-			sortPacketHandlers(e.handlers[listener.ID])
-		}
-	}
+func newEvents() Events {
+	return Events{handlers: make([][]listener, packetid.ClientboundPacketIDGuard)}
 }
 
+// ListenerID identifies the listeners added by one call, to remove them.
+type ListenerID uint64
+
+// ErrorPolicy tells what an error of a handler does.
+type ErrorPolicy int
+
+const (
+	// ErrorReturn skips the handlers after and has HandleGame return the
+	// error as a PacketHandlerError, for its caller to decide.
+	ErrorReturn ErrorPolicy = iota
+	// ErrorContinue hands the error to Events.OnError and goes on with the
+	// handlers after.
+	ErrorContinue
+	// ErrorAbort ends the session: HandleGame returns an AbortError.
+	ErrorAbort
+)
+
 type PacketHandler struct {
 	ID       packetid.ClientboundPacketID
 	Priority int
 	F        func(p pk.Packet) error
+	OnError  ErrorPolicy
+}
+
+// Middleware wraps the handlers, e.g. to time or log them. It returns the
+// function run instead of h.F, which calls h.F to go on.
+type Middleware func(h PacketHandler) func(p pk.Packet) error
+
+type listener struct {
+	PacketHandler
+	id ListenerID
+	// f is F wrapped in the middlewares.
+	f func(p pk.Packet) error
+}
+
+var ErrInvalidPacketID = errors.New("invalid packet ID")
+
+// AddListener adds handlers for the packets of their ID. It adds none if
+// an ID is out of range.
+func (e *Events) AddListener(listeners ...PacketHandler) (ListenerID, error) {
+	for _, l := range listeners {
+		if l.ID < 0 || int(l.ID) >= len(e.handlers) {
+			return 0, fmt.Errorf("bot: %w %d", ErrInvalidPacketID, l.ID)
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.lastID++
+	for _, l := range listeners {
+		e.handlers[l.ID] = e.insert(e.handlers[l.ID], l)
+	}
+
+	return e.lastID, nil
+}
+
+// Handled returns the IDs of the packets there are handlers for, in
+// order.
+func (e *Events) Handled() []packetid.ClientboundPacketID {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var ids []packetid.ClientboundPacketID
+	for id, ls := range e.handlers {
+		if len(ls) > 0 {
+			ids = append(ids, packetid.ClientboundPacketID(id))
+		}
+	}
+
+	return ids
+}
+
+// AddGenericListener adds handlers for every packet, their ID is ignored.
+// Packets of newer versions the client has no ID for only reach these.
+func (e *Events) AddGenericListener(listeners ...PacketHandler) ListenerID {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.lastID++
+	for _, l := range listeners {
+		e.generic = e.insert(e.generic, l)
+	}
+
+	return e.lastID
+}
+
+// RemoveListener removes the listeners added with id.
+func (e *Events) RemoveListener(id ListenerID) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	added := func(l listener) bool { return l.id == id }
+
+	if slices.ContainsFunc(e.generic, added) {
+		e.generic = slices.DeleteFunc(slices.Clone(e.generic), added)
+	}
+
+	for i, ls := range e.handlers {
+		if slices.ContainsFunc(ls, added) {
+			e.handlers[i] = slices.DeleteFunc(slices.Clone(ls), added)
+		}
+	}
+}
+
+// Use wraps every handler, also the ones added already, in middlewares.
+// The first middleware added runs first.
+func (e *Events) Use(middlewares ...Middleware) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.middlewares = append(e.middlewares, middlewares...)
+
+	rewrap := func(ls []listener) []listener {
+		ls = slices.Clone(ls)
+		for i := range ls {
+			ls[i].f = e.wrap(ls[i].PacketHandler)
+		}
+
+		return ls
+	}
+
+	e.generic = rewrap(e.generic)
+	for i, ls := range e.handlers {
+		if ls != nil {
+			e.handlers[i] = rewrap(ls)
+		}
+	}
+}
+
+// insert returns a copy of ls with h added after the ones of the same
+// priority.
+func (e *Events) insert(ls []listener, h PacketHandler) []listener {
+	ls = append(slices.Clone(ls), listener{PacketHandler: h, id: e.lastID, f: e.wrap(h)})
+	sortPacketHandlers(ls)
+
+	return ls
 }
 
-func sortPacketHandlers(slice []PacketHandler) {
+func (e *Events) wrap(h PacketHandler) func(p pk.Packet) error {
+	for _, m := range slices.Backward(e.middlewares) {
+		h.F = m(h)
+	}
+
+	return h.F
+}
+
+// listeners returns the handlers of a packet, the generic ones first.
+func (e *Events) listeners(id packetid.ClientboundPacketID) (generic, handlers []listener) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	// Packets of newer versions the bot has no ID for only reach the
+	// generic handlers.
+	if id < 0 || int(id) >= len(e.handlers) {
+		return e.generic, nil
+	}
+
+	return e.generic, e.handlers[id]
+}
+
+func sortPacketHandlers(slice []listener) {
 	sort.SliceStable(slice, func(i, j int) bool {
 		return slice[i].Priority > slice[j].Priority
 	})
 }
+
+// AbortError is returned by HandleGame when a handler with the ErrorAbort
+// policy failed.
+type AbortError struct {
+	PacketHandlerError
+}
+
+func (a AbortError) Error() string {
+	return "session aborted: " + a.PacketHandlerError.Error()
+}
+
+func (a AbortError) Unwrap() error {
+	return a.Err
+}
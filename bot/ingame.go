@@ -1,19 +1,31 @@
 package bot
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"runtime/debug"
 
 	"mcAfkGo/data/packetid"
 	pk "mcAfkGo/net/packet"
 )
 
+// HandleGame handles the packets of the play state until the connection
+// ends or a handler fails. Called again after a handler error, it goes on
+// with the packets after the one that failed, also within a bundle.
 func (c *Client) HandleGame() error {
+	err := c.handleBundle()
+	if err != nil {
+		return err
+	}
+
 	for {
 		var packet pk.Packet
 
 		err := c.Conn.ReadPacket(&packet)
 		if err != nil {
+			Publish(c.Bus, Disconnected{Err: err, Reason: c.kickReason})
+
 			return err
 		}
 
@@ -22,6 +34,11 @@ func (c *Client) HandleGame() error {
 			if err != nil {
 				return err
 			}
+		} else if packet.ID == int32(packetid.ClientboundStartConfiguration) {
+			err := c.handleStartConfiguration(packet)
+			if err != nil {
+				return err
+			}
 		} else {
 			err := c.handlePacket(packet)
 			if err != nil {
@@ -33,59 +50,145 @@ func (c *Client) HandleGame() error {
 	}
 }
 
+// handleStartConfiguration runs a configuration the server started, e.g.
+// to move the client to another server behind a proxy. The reader stopped
+// at the packet, so it configures even if a handler of it failed.
+func (c *Client) handleStartConfiguration(packet pk.Packet) error {
+	herr := c.handlePacket(packet)
+
+	err := c.Conn.reconfigure(c.joinConfiguration)
+	if err != nil {
+		Publish(c.Bus, Disconnected{Err: err, Reason: c.kickReason})
+
+		return err
+	}
+
+	return herr
+}
+
+// reportPrefix is how many bytes of a packet an error report shows.
+const reportPrefix = 32
+
+// PacketHandlerError reports a handler failing on a packet, with the start
+// of the packet to tell what it was.
 type PacketHandlerError struct {
 	ID  packetid.ClientboundPacketID
 	Err error
+	// Length is the length of the packet's data, Prefix its first bytes.
+	Length int
+	Prefix []byte
+}
+
+func newPacketHandlerError(p pk.Packet, err error) PacketHandlerError {
+	return PacketHandlerError{
+		ID:     packetid.ClientboundPacketID(p.ID),
+		Err:    err,
+		Length: len(p.Data),
+		// The data goes back to the pool.
+		Prefix: bytes.Clone(p.Data[:min(len(p.Data), reportPrefix)]),
+	}
 }
 
 func (d PacketHandlerError) Error() string {
-	return fmt.Sprintf("handle packet %v error: %v", d.ID, d.Err)
+	more := ""
+	if d.Length > len(d.Prefix) {
+		more = "..."
+	}
+
+	return fmt.Sprintf("handle packet %v (%d bytes: %x%s) error: %v", d.ID, d.Length, d.Prefix, more, d.Err)
+}
+
+func (d PacketHandlerError) Unwrap() error {
+	return d.Err
 }
 
-func (c *Client) handleBundlePackets() (err error) {
+// PanicError is the error of a handler that panicked.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (p PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+func (c *Client) handleBundlePackets() error {
 	var packets []pk.Packet
 	for i := 0; i < 4096; i++ {
 		var packet pk.Packet
 		err := c.Conn.ReadPacket(&packet)
 		if err != nil {
+			Publish(c.Bus, Disconnected{Err: err, Reason: c.kickReason})
+
 			return err
 		}
 
 		if packet.ID == int32(packetid.BundleDelimiter) {
-			goto handlePackets
+			c.bundle = packets
+
+			return c.handleBundle()
 		}
 
 		packets = append(packets, packet)
 	}
 
 	return errors.New("packet number of a bundle out of limit")
+}
+
+// handleBundle handles the packets of c.bundle. A handler error returns
+// with the packets after the failed one left there.
+func (c *Client) handleBundle() error {
+	for len(c.bundle) > 0 {
+		packet := c.bundle[0]
+		c.bundle = c.bundle[1:]
 
-handlePackets:
-	for i := range packets {
-		err := c.handlePacket(packets[i])
+		err := c.handlePacket(packet)
 		if err != nil {
 			return err
 		}
 	}
 
+	c.bundle = nil
+
 	return nil
 }
 
-func (c *Client) handlePacket(p pk.Packet) (err error) {
+func (c *Client) handlePacket(p pk.Packet) error {
 	packetID := packetid.ClientboundPacketID(p.ID)
-	for _, handler := range c.Events.generic {
-		err = handler.F(p)
-		if err != nil {
-			return PacketHandlerError{ID: packetID, Err: err}
+	generic, handlers := c.Events.listeners(packetID)
+
+	for _, ls := range [2][]listener{generic, handlers} {
+		for _, l := range ls {
+			err := call(l.f, p)
+			if err == nil {
+				continue
+			}
+
+			herr := newPacketHandlerError(p, err)
+
+			switch l.OnError {
+			case ErrorContinue:
+				if c.Events.OnError != nil {
+					c.Events.OnError(herr)
+				}
+			case ErrorAbort:
+				return AbortError{herr}
+			default:
+				return herr
+			}
 		}
 	}
 
-	for _, handler := range c.Events.handlers[packetID] {
-		err = handler.F(p)
-		if err != nil {
-			return PacketHandlerError{ID: packetID, Err: err}
+	return nil
+}
+
+// call runs handler f, turning a panic into a PanicError.
+func call(f func(p pk.Packet) error, p pk.Packet) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = PanicError{Value: v, Stack: debug.Stack()}
 		}
-	}
+	}()
 
-	return
+	return f(p)
 }
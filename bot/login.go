@@ -1,7 +1,6 @@
 package bot
 
 import (
-	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
@@ -9,14 +8,14 @@ import (
 	"crypto/sha1"
 	"crypto/x509"
 	"encoding/hex"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
 
 	"github.com/google/uuid"
 
+	"mcAfkGo/auth"
 	"mcAfkGo/chat"
 	"mcAfkGo/data/packetid"
 	"mcAfkGo/net"
@@ -33,6 +32,10 @@ func (l LoginErr) Error() string {
 	return "bot: login error: [" + l.Stage + "] " + l.Err.Error()
 }
 
+func (l LoginErr) Unwrap() error {
+	return l.Err
+}
+
 func (c *Client) joinLogin(conn *net.Conn) error {
 	var err error
 	if c.Auth.UUID != "" {
@@ -162,6 +165,41 @@ type Auth struct {
 	AsTk string
 }
 
+func (a Auth) profile() auth.Profile {
+	return auth.Profile{Name: a.Name, UUID: a.UUID, AccessToken: a.AsTk}
+}
+
+// Authenticate asks the client's Authenticator for a profile and stores it
+// in Auth, ready for JoinServer.
+func (c *Client) Authenticate() error {
+	if c.Authenticator == nil {
+		return LoginErr{"authenticate", errors.New("no authenticator configured")}
+	}
+
+	profile, err := c.Authenticator.Authenticate()
+	if err != nil {
+		return LoginErr{"authenticate", err}
+	}
+
+	c.Auth = Auth{
+		Name: profile.Name,
+		UUID: profile.UUID,
+		AsTk: profile.AccessToken,
+	}
+
+	return nil
+}
+
+// authenticator falls back to the access token in Auth for clients that
+// set it directly instead of configuring an Authenticator.
+func (c *Client) authenticator() auth.Authenticator {
+	if c.Authenticator != nil {
+		return c.Authenticator
+	}
+
+	return auth.NewTokenAuthenticator(nil, c.Auth.profile())
+}
+
 func handleEncryptionRequest(conn *net.Conn, c *Client, p pk.Packet) error {
 	key, encoStream, decoStream := newSymmetricEncryption()
 
@@ -170,12 +208,15 @@ func handleEncryptionRequest(conn *net.Conn, c *Client, p pk.Packet) error {
 		return err
 	}
 
-	err := loginAuth(c.Auth, key, er)
-	if err != nil {
-		return fmt.Errorf("login fail: %v", err)
+	if er.ShouldAuthenticate {
+		digest := authDigest(er.ServerID, key, er.PublicKey)
+		err := c.authenticator().JoinServer(c.Auth.profile(), digest)
+		if err != nil {
+			return fmt.Errorf("login fail: %v", err)
+		}
 	}
 
-	p, err = genEncryptionKeyResponse(key, er.PublicKey, er.VerifyToken)
+	p, err := genEncryptionKeyResponse(key, er.PublicKey, er.VerifyToken)
 	if err != nil {
 		return fmt.Errorf("gen encryption key response fail: %v", err)
 	}
@@ -191,9 +232,10 @@ func handleEncryptionRequest(conn *net.Conn, c *Client, p pk.Packet) error {
 }
 
 type encryptionRequest struct {
-	ServerID    string
-	PublicKey   []byte
-	VerifyToken []byte
+	ServerID           string
+	PublicKey          []byte
+	VerifyToken        []byte
+	ShouldAuthenticate bool
 }
 
 func (e *encryptionRequest) ReadFrom(r io.Reader) (int64, error) {
@@ -201,6 +243,7 @@ func (e *encryptionRequest) ReadFrom(r io.Reader) (int64, error) {
 		(*pk.String)(&e.ServerID),
 		(*pk.ByteArray)(&e.PublicKey),
 		(*pk.ByteArray)(&e.VerifyToken),
+		(*pk.Boolean)(&e.ShouldAuthenticate),
 	}.ReadFrom(r)
 }
 
@@ -238,60 +281,6 @@ func twosComplement(p []byte) []byte {
 	return p
 }
 
-type profile struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-}
-
-type request struct {
-	AccessToken     string  `json:"accessToken"`
-	SelectedProfile profile `json:"selectedProfile"`
-	ServerID        string  `json:"serverId"`
-}
-
-func loginAuth(auth Auth, shareSecret []byte, er encryptionRequest) error {
-	digest := authDigest(er.ServerID, shareSecret, er.PublicKey)
-
-	requestPacket, err := json.Marshal(
-		request{
-			AccessToken: auth.AsTk,
-			SelectedProfile: profile{
-				ID:   auth.UUID,
-				Name: auth.Name,
-			},
-			ServerID: digest,
-		},
-	)
-	if err != nil {
-		return fmt.Errorf("create request packet to auth failed: %v", err)
-	}
-
-	PostRequest, err := http.NewRequest(http.MethodPost, "https://sessionserver.mojang.com/session/minecraft/join",
-		bytes.NewReader(requestPacket))
-	if err != nil {
-		return fmt.Errorf("make request error: %v", err)
-	}
-
-	PostRequest.Header.Set("User-agent", "go-mc")
-	PostRequest.Header.Set("Connection", "keep-alive")
-	PostRequest.Header.Set("Content-Type", "application/json")
-	resp, err := http.DefaultClient.Do(PostRequest)
-	if err != nil {
-		return fmt.Errorf("post fail: %v", err)
-	}
-
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("msauth fail: %s", string(body))
-	}
-
-	return nil
-}
-
 func newSymmetricEncryption() (key []byte, encoStream, decoStream cipher.Stream) {
 	key = make([]byte, 16)
 	if _, err := rand.Read(key); err != nil {
@@ -8,11 +8,15 @@ import (
 
 	"mcAfkGo/auth/user"
 	"mcAfkGo/chat"
+	"mcAfkGo/data/protocol"
 	mcnet "mcAfkGo/net"
+	"mcAfkGo/net/capture"
 	pk "mcAfkGo/net/packet"
 	"mcAfkGo/net/queue"
 )
 
+// ProtocolVersion is the protocol version spoken unless
+// JoinOptions.Protocol says otherwise.
 const ProtocolVersion = 767
 
 type JoinOptions struct {
@@ -23,8 +27,18 @@ type JoinOptions struct {
 
 	KeyPair *user.KeyPairResp
 
+	// QueueRead and QueueWrite default to NewReceiveQueue and NewSendQueue
+	// of the default sizes.
 	QueueRead  queue.Queue[pk.Packet]
 	QueueWrite queue.Queue[pk.Packet]
+
+	// Capture, if set, sees every packet of the connection, e.g. a
+	// capture.Recorder writing them to a file.
+	Capture capture.Tap
+
+	// Protocol is the version to speak, usually the one the server
+	// reports in its status. It defaults to protocol.Latest.
+	Protocol *protocol.Version
 }
 
 func (c *Client) JoinServer(addr string) (err error) {
@@ -41,17 +55,21 @@ func (c *Client) JoinServerWithOptions(addr string, options JoinOptions) (err er
 	}
 
 	if options.QueueRead == nil {
-		options.QueueRead = queue.NewLinkedQueue[pk.Packet]()
+		options.QueueRead = NewReceiveQueue(ReceiveQueueSize)
 	}
 
 	if options.QueueWrite == nil {
-		options.QueueWrite = queue.NewLinkedQueue[pk.Packet]()
+		options.QueueWrite = NewSendQueue(SendQueueSize)
+	}
+
+	if options.Protocol == nil {
+		options.Protocol = protocol.Latest
 	}
 
 	return c.join(addr, options)
 }
 
-func (c *Client) join(addr string, options JoinOptions) error {
+func (c *Client) join(addr string, options JoinOptions) (err error) {
 	const Handshake = 0x00
 
 	host, portStr, err := net.SplitHostPort(addr)
@@ -77,9 +95,34 @@ func (c *Client) join(addr string, options JoinOptions) error {
 		return LoginErr{"connect server", err}
 	}
 
+	defer func() {
+		if err != nil {
+			_ = conn.Close()
+		}
+	}()
+
+	if options.Capture != nil {
+		conn.Tap = options.Capture
+	}
+
+	setState := func(state capture.State) {
+		if options.Capture != nil {
+			options.Capture.SetState(state)
+		}
+
+		// Assign only a non-nil *Mapper, a nil one would make a non-nil
+		// interface.
+		conn.IDs = nil
+		if m := options.Protocol.Mapper(state); m != nil {
+			conn.IDs = m
+		}
+	}
+
+	setState(capture.StateHandshake)
+
 	err = conn.WritePacket(pk.Marshal(
 		Handshake,
-		pk.VarInt(ProtocolVersion),
+		pk.VarInt(options.Protocol.Protocol),
 		pk.String(host),
 		pk.UnsignedShort(port),
 		pk.VarInt(2),
@@ -88,17 +131,25 @@ func (c *Client) join(addr string, options JoinOptions) error {
 		return LoginErr{"handshake", err}
 	}
 
+	setState(capture.StateLogin)
+
 	err = c.joinLogin(conn)
 	if err != nil {
 		return err
 	}
 
+	setState(capture.StateConfiguration)
+
 	err = c.joinConfiguration(conn)
 	if err != nil {
 		return err
 	}
 
-	c.Conn = warpConn(conn, options.QueueRead, options.QueueWrite)
+	setState(capture.StatePlay)
+
+	c.Conn = warpConn(conn, options.QueueRead, options.QueueWrite, setState)
+	c.bundle = nil
+	Publish(c.Bus, Connected{Address: addr})
 
 	return nil
 }
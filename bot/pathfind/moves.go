@@ -0,0 +1,141 @@
+package pathfind
+
+import "mcAfkGo/data/block"
+
+type move struct {
+	to   Pos
+	cost float64
+}
+
+var (
+	up   = Pos{Y: 1}
+	down = Pos{Y: -1}
+
+	straight = []Pos{{X: 1}, {X: -1}, {Z: 1}, {Z: -1}}
+	diagonal = []Pos{{X: 1, Z: 1}, {X: 1, Z: -1}, {X: -1, Z: 1}, {X: -1, Z: -1}}
+)
+
+// grid answers what the player can do at a block. Blocks in chunks that
+// aren't loaded are neither passable nor solid.
+type grid struct {
+	w World
+}
+
+// passable reports whether the player can be in the block at p. Fences
+// and walls below reach into it.
+func (g grid) passable(p Pos) bool {
+	state, ok := g.w.BlockAt(p.X, p.Y, p.Z)
+	if !ok || block.HasCollision(state) || block.IsLava(state) {
+		return false
+	}
+
+	below, _ := g.w.BlockAt(p.X, p.Y-1, p.Z)
+	for _, b := range block.Shape(below) {
+		if b.MaxY > 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (g grid) solid(p Pos) bool {
+	state, ok := g.w.BlockAt(p.X, p.Y, p.Z)
+
+	return ok && block.HasCollision(state)
+}
+
+func (g grid) water(p Pos) bool {
+	state, ok := g.w.BlockAt(p.X, p.Y, p.Z)
+
+	return ok && block.IsWater(state)
+}
+
+// fits reports whether the player's feet and head fit at p.
+func (g grid) fits(p Pos) bool {
+	return g.passable(p) && g.passable(p.Add(up))
+}
+
+// standable reports whether the player can stand or swim at p.
+func (g grid) standable(p Pos) bool {
+	return g.fits(p) && (g.solid(p.Add(down)) || g.water(p))
+}
+
+// Standable reports whether the player can stand or swim at p, which
+// every block of a path is.
+func Standable(w World, p Pos) bool {
+	return grid{w}.standable(p)
+}
+
+// moves returns the blocks the player gets to from p in one move: walking
+// and swimming to the sides, jumping up a block, dropping down a few and
+// swimming up and down.
+func (g grid) moves(p Pos) []move {
+	var moves []move
+
+	swimming := g.water(p)
+
+	for _, d := range straight {
+		q := p.Add(d)
+
+		switch {
+		case g.standable(q):
+			cost := float64(costWalk)
+			if swimming || g.water(q) {
+				cost = costSwim
+			}
+
+			moves = append(moves, move{q, cost})
+
+		case g.fits(q):
+			// Walk off the edge and land below.
+			if to, fell, ok := g.fall(q); ok {
+				moves = append(moves, move{to, costFall + costPerFall*float64(fell)})
+			}
+
+		case g.standable(q.Add(up)) && g.passable(p.Add(up).Add(up)):
+			moves = append(moves, move{q.Add(up), costJump})
+		}
+	}
+
+	// Diagonals only on level ground, with both sides free so the player
+	// doesn't catch a corner.
+	if !swimming {
+		for _, d := range diagonal {
+			q := p.Add(d)
+			if g.standable(q) && !g.water(q) && g.fits(p.Add(Pos{X: d.X})) && g.fits(p.Add(Pos{Z: d.Z})) {
+				moves = append(moves, move{q, costDiagonal})
+			}
+		}
+	}
+
+	if swimming {
+		if q := p.Add(up); g.water(q) && g.fits(q) {
+			moves = append(moves, move{q, costSwim})
+		}
+
+		if q := p.Add(down); g.water(q) {
+			moves = append(moves, move{q, costSwim})
+		}
+	}
+
+	return moves
+}
+
+// fall returns where the player lands dropping down from p, which has
+// nothing to stand on, and how many blocks it fell. Water breaks any fall.
+func (g grid) fall(p Pos) (Pos, int, bool) {
+	for fell := 0; ; fell++ {
+		below := p.Add(down)
+		if g.solid(below) || g.water(p) {
+			return p, fell, true
+		}
+
+		// Lava, unloaded chunks and falls that would hurt end the move.
+		if !g.passable(below) || fell >= maxFall && !g.water(below) {
+			return Pos{}, 0, false
+		}
+
+		p = below
+	}
+}
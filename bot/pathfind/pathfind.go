@@ -0,0 +1,167 @@
+// Package pathfind finds paths for the player through the blocks of the
+// world with A*. A path is the list of blocks the player's feet pass.
+package pathfind
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+)
+
+type World interface {
+	BlockAt(x, y, z int) (int32, bool)
+}
+
+type Pos struct{ X, Y, Z int }
+
+func (p Pos) Add(o Pos) Pos { return Pos{p.X + o.X, p.Y + o.Y, p.Z + o.Z} }
+
+func (p Pos) DistanceTo(o Pos) float64 {
+	dx, dy, dz := float64(p.X-o.X), float64(p.Y-o.Y), float64(p.Z-o.Z)
+
+	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+// DefaultMaxNodes is the search budget of Find if none is given.
+const DefaultMaxNodes = 20000
+
+// maxFall is how many blocks the player drops down at most, keeping fall
+// damage away.
+const maxFall = 3
+
+// Move costs, in blocks walked.
+const (
+	costWalk     = 1
+	costDiagonal = math.Sqrt2
+	costJump     = 2
+	costFall     = 1
+	costPerFall  = 0.5
+	costSwim     = 2
+)
+
+var ErrNoPath = errors.New("pathfind: no path")
+
+// estimate returns a lower bound of the cost from p to the goal, so that
+// A* finds the cheapest path. Every move costs at least the distance it
+// covers to the sides, half a block for each block it drops and two for
+// each block it climbs, so the cost is at least the largest of them.
+func estimate(p, goal Pos) float64 {
+	dx, dz := float64(goal.X-p.X), float64(goal.Z-p.Z)
+	h := math.Sqrt(dx*dx + dz*dz)
+
+	if dy := float64(goal.Y - p.Y); dy > 0 {
+		h = max(h, dy*costJump)
+	} else {
+		h = max(h, -dy*costPerFall)
+	}
+
+	return h
+}
+
+// Find returns a path from the block from to the block to, both included.
+// It gives up after visiting maxNodes blocks, DefaultMaxNodes if 0, and
+// then returns a path to the visited block closest to the goal with
+// complete unset, which is also how far it gets when the goal is in
+// chunks that aren't loaded. ErrNoPath is returned if no block is closer
+// than from.
+func Find(w World, from, to Pos, maxNodes int) (path []Pos, complete bool, err error) {
+	if maxNodes <= 0 {
+		maxNodes = DefaultMaxNodes
+	}
+
+	g := grid{w}
+
+	start := &node{pos: from, h: estimate(from, to)}
+	nodes := map[Pos]*node{from: start}
+	open := &queue{start}
+	best := start
+
+	for visited := 0; open.Len() > 0 && visited < maxNodes; visited++ {
+		n := heap.Pop(open).(*node)
+		n.closed = true
+
+		if n.pos == to {
+			return n.path(), true, nil
+		}
+
+		if n.h < best.h {
+			best = n
+		}
+
+		for _, m := range g.moves(n.pos) {
+			cost := n.g + m.cost
+
+			next, ok := nodes[m.to]
+			if ok && (next.closed || cost >= next.g) {
+				continue
+			}
+
+			if !ok {
+				next = &node{pos: m.to, h: estimate(m.to, to)}
+				nodes[m.to] = next
+			}
+
+			next.g, next.parent = cost, n
+			if ok {
+				heap.Fix(open, next.index)
+			} else {
+				heap.Push(open, next)
+			}
+		}
+	}
+
+	if best == start {
+		return nil, false, ErrNoPath
+	}
+
+	return best.path(), false, nil
+}
+
+type node struct {
+	pos    Pos
+	g, h   float64
+	parent *node
+	closed bool
+	// index is the position in the queue, -1 once popped.
+	index int
+}
+
+func (n *node) path() []Pos {
+	var path []Pos
+	for ; n != nil; n = n.parent {
+		path = append(path, n.pos)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+// queue is a heap of the open nodes, lowest estimated cost first.
+type queue []*node
+
+func (q queue) Len() int { return len(q) }
+
+func (q queue) Less(i, j int) bool { return q[i].g+q[i].h < q[j].g+q[j].h }
+
+func (q queue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index, q[j].index = i, j
+}
+
+func (q *queue) Push(x any) {
+	n := x.(*node)
+	n.index = len(*q)
+	*q = append(*q, n)
+}
+
+func (q *queue) Pop() any {
+	old := *q
+	n := old[len(old)-1]
+	n.index = -1
+	*q = old[:len(old)-1]
+
+	return n
+}
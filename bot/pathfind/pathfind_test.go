@@ -0,0 +1,78 @@
+package pathfind
+
+import (
+	"fmt"
+	"testing"
+)
+
+const (
+	stone      = 1
+	shortGrass = 2005
+)
+
+// world is stone up to the height in floor at each x, z and air above it,
+// with blocks in place of those. Blocks outside floor aren't loaded.
+type world struct {
+	floor  [][]int
+	blocks map[Pos]int32
+}
+
+func (w world) BlockAt(x, y, z int) (int32, bool) {
+	if x < 0 || x >= len(w.floor) || z < 0 || z >= len(w.floor[x]) || y < 0 || y > 8 {
+		return 0, false
+	}
+
+	if state, ok := w.blocks[Pos{x, y, z}]; ok {
+		return state, true
+	}
+
+	if y < w.floor[x][z] {
+		return stone, true
+	}
+
+	return 0, true
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name     string
+		w        world
+		from, to Pos
+		want     []Pos
+	}{
+		{
+			name: "through grass",
+			w: world{
+				floor:  [][]int{{1}, {1}, {1}, {1}, {1}},
+				blocks: map[Pos]int32{{1, 1, 0}: shortGrass, {2, 1, 0}: shortGrass, {2, 2, 0}: shortGrass},
+			},
+			from: Pos{0, 1, 0},
+			to:   Pos{4, 1, 0},
+			want: []Pos{{0, 1, 0}, {1, 1, 0}, {2, 1, 0}, {3, 1, 0}, {4, 1, 0}},
+		},
+		{
+			// Dropping three blocks at once is cheaper than the two
+			// drops down the steps straight ahead.
+			name: "drop",
+			w: world{
+				floor: [][]int{{4, 2, 1}, {4, 4, 1}},
+			},
+			from: Pos{0, 4, 0},
+			to:   Pos{1, 1, 2},
+			want: []Pos{{0, 4, 0}, {1, 4, 1}, {1, 1, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, complete, err := Find(tt.w, tt.from, tt.to, 0)
+			if err != nil || !complete {
+				t.Fatalf("Find = %v, %v, %v", path, complete, err)
+			}
+
+			if fmt.Sprint(path) != fmt.Sprint(tt.want) {
+				t.Errorf("path %v, want %v", path, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package physics
+
+import (
+	"errors"
+	"math"
+
+	"mcAfkGo/bot/pathfind"
+	"mcAfkGo/data/block"
+	"mcAfkGo/maths"
+)
+
+const (
+	// waypointReach is how close to the center of a block of its path the
+	// player has to get before heading to the next one.
+	waypointReach = 0.3
+	// lookahead is how many blocks of the path ahead are checked for
+	// changes every tick.
+	lookahead = 4
+	// stuckTicks is how long the player may take to the next block of its
+	// path before it looks for another path.
+	stuckTicks = 40
+	// maxFailures is how often in a row a path may fail before the player
+	// gives up.
+	maxFailures = 3
+)
+
+var ErrNotReady = errors.New("player not placed in the world yet")
+
+// Goto has the player walk to target along a path, which becomes its
+// anchor once there. It fails if no path leads closer to target.
+func (ph *Physics) Goto(target maths.Vec3) error {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	if !ph.ready {
+		return Error{ErrNotReady}
+	}
+
+	ph.goal, ph.hasGoal, ph.failures = target, true, 0
+
+	err := ph.findPath()
+	if err != nil {
+		ph.hasGoal = false
+
+		return Error{err}
+	}
+
+	return nil
+}
+
+// StopGoto stops walking to the destination, the player stays where it is.
+func (ph *Physics) StopGoto() {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	if ph.hasGoal {
+		ph.giveUp()
+	}
+}
+
+// Destination returns where the player is walking to, false if it isn't.
+func (ph *Physics) Destination() (maths.Vec3, bool) {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	return ph.goal, ph.hasGoal
+}
+
+// findPath looks for a path from the player's block to the goal's.
+func (ph *Physics) findPath() error {
+	from := blockAt(ph.pos)
+	if !pathfind.Standable(ph.w, from) {
+		// The feet are a hair inside the block below.
+		from = from.Add(pathfind.Pos{Y: 1})
+	}
+
+	path, complete, err := pathfind.Find(ph.w, from, blockAt(ph.goal), 0)
+	if err != nil {
+		return err
+	}
+
+	ph.path, ph.pathComplete, ph.waypoint, ph.sinceWaypoint = path, complete, 1, 0
+
+	return nil
+}
+
+// followPath turns the player towards the next block of its path and
+// returns whether it should walk and whether it should dive. It finds a
+// new path when the blocks ahead changed, the player got stuck or the path
+// only led part of the way.
+func (ph *Physics) followPath() (walk, sink bool) {
+	if ph.path == nil || ph.pathChanged() || ph.sinceWaypoint > stuckTicks {
+		ph.repath()
+
+		return false, false
+	}
+
+	for ph.waypoint < len(ph.path) && ph.reached(ph.path[ph.waypoint]) {
+		ph.waypoint++
+		ph.sinceWaypoint, ph.failures = 0, 0
+	}
+
+	if ph.waypoint == len(ph.path) {
+		if ph.pathComplete {
+			ph.anchor, ph.hasAnchor, ph.returning = ph.goal, true, true
+			ph.hasGoal, ph.path = false, nil
+
+			return false, false
+		}
+
+		ph.repath()
+
+		return false, false
+	}
+
+	ph.sinceWaypoint++
+
+	next := ph.path[ph.waypoint]
+	d := center(next).Sub(ph.pos)
+
+	if math.Hypot(d.X, d.Z) > waypointReach/2 {
+		ph.yaw = float32(math.Atan2(-d.X, d.Z) * 180 / math.Pi)
+		walk = true
+	}
+
+	return walk, float64(next.Y) < math.Floor(ph.pos.Y)
+}
+
+// repath finds a new path once the player is on the ground or swimming,
+// and gives up after maxFailures failed paths in a row.
+func (ph *Physics) repath() {
+	if !ph.onGround && !ph.inFluid(block.IsWater) {
+		return
+	}
+
+	ph.failures++
+	if ph.failures > maxFailures || ph.findPath() != nil {
+		ph.giveUp()
+	}
+}
+
+// giveUp stops following the path and keeps the player where it is.
+func (ph *Physics) giveUp() {
+	ph.hasGoal, ph.path = false, nil
+	ph.anchor, ph.hasAnchor, ph.returning = ph.pos, true, false
+}
+
+// pathChanged reports whether a block of the path ahead was changed so
+// the player can't stand there anymore.
+func (ph *Physics) pathChanged() bool {
+	for _, p := range ph.path[ph.waypoint:min(ph.waypoint+lookahead, len(ph.path))] {
+		if !pathfind.Standable(ph.w, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reached reports whether the player arrived in block p.
+func (ph *Physics) reached(p pathfind.Pos) bool {
+	d := center(p).Sub(ph.pos)
+
+	return math.Hypot(d.X, d.Z) < waypointReach && ph.pos.Y > float64(p.Y)-0.1 && ph.pos.Y < float64(p.Y+1)
+}
+
+func blockAt(pos maths.Vec3) pathfind.Pos {
+	x, y, z := pos.Floor()
+
+	return pathfind.Pos{X: x, Y: y, Z: z}
+}
+
+// center returns the bottom center of block p.
+func center(p pathfind.Pos) maths.Vec3 {
+	return maths.Vec3{X: float64(p.X) + 0.5, Y: float64(p.Y), Z: float64(p.Z) + 0.5}
+}
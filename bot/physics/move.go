@@ -0,0 +1,263 @@
+package physics
+
+import (
+	"math"
+
+	"mcAfkGo/data/block"
+	"mcAfkGo/maths"
+)
+
+// Movement constants of the vanilla player.
+const (
+	width      = 0.6
+	height     = 1.8
+	stepHeight = 0.6
+
+	gravity      = 0.08
+	verticalDrag = 0.98
+	airDrag      = 0.91
+	// blockFriction is the friction of every block but ice and slime.
+	blockFriction = 0.6
+
+	walkSpeed  = 0.1
+	airSpeed   = 0.02
+	fluidSpeed = 0.02
+	jumpPower  = 0.42
+	swimUp     = 0.04
+
+	waterDrag = 0.8
+	lavaDrag  = 0.5
+)
+
+func (ph *Physics) box() maths.AABB {
+	return boxAt(ph.pos)
+}
+
+func boxAt(pos maths.Vec3) maths.AABB {
+	return maths.AABB{
+		Min: maths.Vec3{X: pos.X - width/2, Y: pos.Y, Z: pos.Z - width/2},
+		Max: maths.Vec3{X: pos.X + width/2, Y: pos.Y + height, Z: pos.Z + width/2},
+	}
+}
+
+// travel moves the player by one tick of velocity, walking forward if
+// walk is set. In fluids it swims up unless sink is set.
+func (ph *Physics) travel(walk, sink bool) {
+	var input float64
+	if walk {
+		input = 0.98
+	}
+
+	water, lava := ph.inFluid(block.IsWater), ph.inFluid(block.IsLava)
+
+	switch {
+	case water || lava:
+		// Swim up, which keeps the player's head above the surface.
+		if !sink {
+			ph.vel.Y += swimUp
+		}
+		ph.accelerate(input, fluidSpeed)
+
+		if water {
+			ph.vel = ph.vel.Add(ph.waterFlow())
+		}
+
+		ph.move(ph.vel)
+
+		if water {
+			ph.vel = ph.vel.Scale(waterDrag)
+			ph.vel.Y -= gravity / 16
+		} else {
+			ph.vel = ph.vel.Scale(lavaDrag)
+			ph.vel.Y -= gravity / 4
+		}
+
+		// Climb out at the shore.
+		if ph.horizontalCollision && ph.fits(ph.vel.Add(maths.Vec3{Y: 0.6})) {
+			ph.vel.Y = 0.3
+		}
+
+	default:
+		speed := airSpeed
+		if ph.onGround {
+			speed = walkSpeed
+		}
+
+		if walk && ph.onGround && ph.horizontalCollision {
+			ph.vel.Y = jumpPower
+		}
+
+		ph.accelerate(input, speed)
+		ph.move(ph.vel)
+
+		friction := airDrag
+		if ph.onGround {
+			friction = blockFriction * airDrag
+		}
+
+		ph.vel.Y = (ph.vel.Y - gravity) * verticalDrag
+		ph.vel.X *= friction
+		ph.vel.Z *= friction
+	}
+}
+
+// accelerate adds forward input at speed in the direction the player
+// faces.
+func (ph *Physics) accelerate(input, speed float64) {
+	if input == 0 {
+		return
+	}
+
+	yaw := float64(ph.yaw) * math.Pi / 180
+	ph.vel.X += -math.Sin(yaw) * input * speed
+	ph.vel.Z += math.Cos(yaw) * input * speed
+}
+
+// move moves the player by delta, stopping at blocks and stepping up
+// ledges up to stepHeight while on the ground.
+func (ph *Physics) move(delta maths.Vec3) {
+	box := ph.box()
+	moved := ph.collide(box, delta)
+
+	blockedX, blockedZ := moved.X != delta.X, moved.Z != delta.Z
+	landing := delta.Y < 0 && moved.Y != delta.Y
+
+	if (ph.onGround || landing) && (blockedX || blockedZ) {
+		// Try again from stepHeight higher and settle down after.
+		up := ph.collide(box, maths.Vec3{Y: stepHeight})
+		stepped := ph.collide(box.Offset(up), maths.Vec3{X: delta.X, Z: delta.Z})
+		stepped = up.Add(stepped)
+		stepped.Y += ph.collide(box.Offset(stepped), maths.Vec3{Y: -up.Y + min(delta.Y, 0)}).Y
+
+		if math.Hypot(stepped.X, stepped.Z) > math.Hypot(moved.X, moved.Z) {
+			moved = stepped
+			blockedX, blockedZ = moved.X != delta.X, moved.Z != delta.Z
+		}
+	}
+
+	ph.pos = ph.pos.Add(moved)
+	ph.horizontalCollision = blockedX || blockedZ
+	ph.onGround = delta.Y < 0 && moved.Y > delta.Y
+
+	if blockedX {
+		ph.vel.X = 0
+	}
+
+	if moved.Y != delta.Y {
+		ph.vel.Y = 0
+	}
+
+	if blockedZ {
+		ph.vel.Z = 0
+	}
+}
+
+// collide returns how far box gets moving by delta: along y first, then
+// along the longer of x and z.
+func (ph *Physics) collide(box maths.AABB, delta maths.Vec3) maths.Vec3 {
+	blocks := ph.collisionBoxes(box.Expand(delta))
+
+	for _, b := range blocks {
+		delta.Y = box.ClipY(b, delta.Y)
+	}
+
+	box = box.Offset(maths.Vec3{Y: delta.Y})
+
+	clipX := func() {
+		for _, b := range blocks {
+			delta.X = box.ClipX(b, delta.X)
+		}
+
+		box = box.Offset(maths.Vec3{X: delta.X})
+	}
+
+	clipZ := func() {
+		for _, b := range blocks {
+			delta.Z = box.ClipZ(b, delta.Z)
+		}
+
+		box = box.Offset(maths.Vec3{Z: delta.Z})
+	}
+
+	if math.Abs(delta.X) < math.Abs(delta.Z) {
+		clipZ()
+		clipX()
+	} else {
+		clipX()
+		clipZ()
+	}
+
+	return delta
+}
+
+// collisionBoxes returns the block shapes in area the player collides
+// with. Blocks in unloaded chunks are solid. The blocks below area are
+// looked at too, as fences and walls reach into the block above them.
+func (ph *Physics) collisionBoxes(area maths.AABB) []maths.AABB {
+	var boxes []maths.AABB
+
+	x0, y0, z0 := area.Min.Floor()
+	x1, y1, z1 := area.Max.Floor()
+
+	for x := x0; x <= x1; x++ {
+		for z := z0; z <= z1; z++ {
+			for y := y0 - 1; y <= y1; y++ {
+				state, ok := ph.w.BlockAt(x, y, z)
+
+				// Below and above the world is open.
+				if !ok && ph.w.IsLoaded(x, z) {
+					continue
+				}
+
+				if !ok {
+					if y >= y0 {
+						boxes = append(boxes, maths.BlockAABB(x, y, z))
+					}
+
+					continue
+				}
+
+				corner := maths.Vec3{X: float64(x), Y: float64(y), Z: float64(z)}
+				for _, b := range block.Shape(state) {
+					if y < y0 && b.MaxY <= 1 {
+						continue
+					}
+
+					boxes = append(boxes, maths.AABB{
+						Min: corner.Add(maths.Vec3{X: b.MinX, Y: b.MinY, Z: b.MinZ}),
+						Max: corner.Add(maths.Vec3{X: b.MaxX, Y: b.MaxY, Z: b.MaxZ}),
+					})
+				}
+			}
+		}
+	}
+
+	return boxes
+}
+
+// fits reports whether the player could move by delta unobstructed.
+func (ph *Physics) fits(delta maths.Vec3) bool {
+	return ph.collide(ph.box(), delta) == delta
+}
+
+// inFluid reports whether the player's box is in a block the fluid test
+// matches, up to the fluid's surface.
+func (ph *Physics) inFluid(is func(int32) bool) bool {
+	box := ph.box()
+
+	x0, y0, z0 := box.Min.Floor()
+	x1, y1, z1 := box.Max.Floor()
+
+	for x := x0; x <= x1; x++ {
+		for z := z0; z <= z1; z++ {
+			for y := y0; y <= y1; y++ {
+				state, ok := ph.w.BlockAt(x, y, z)
+				if ok && is(state) && box.Min.Y < float64(y)+block.FluidHeight(state) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,430 @@
+// Package physics simulates the player's movement tick by tick, as the
+// vanilla client does, and reports the position to the server.
+package physics
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/bot/entities"
+	"mcAfkGo/bot/pathfind"
+	"mcAfkGo/bot/world"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/maths"
+	pk "mcAfkGo/net/packet"
+)
+
+const TickRate = 20
+
+const (
+	// anchorReach is how close to its anchor the bot stops walking.
+	anchorReach = 0.25
+	// anchorDrift is how far the bot may be pushed before it walks back.
+	anchorDrift = 0.5
+	// anchorMoved is how far the server has to teleport the bot from its
+	// anchor to have it stay at the new place, e.g. after /tp.
+	anchorMoved = 16
+)
+
+type Physics struct {
+	c       *bot.Client
+	p       *basic.Player
+	w       *world.World
+	tracker *entities.Tracker
+
+	mu       sync.Mutex
+	ready    bool
+	alive    bool
+	pos      maths.Vec3
+	vel      maths.Vec3
+	yaw      float32
+	pitch    float32
+	onGround bool
+	// horizontalCollision is set when the last move was blocked sideways.
+	horizontalCollision bool
+
+	anchor    maths.Vec3
+	hasAnchor bool
+	// returning is set while the bot walks back to the anchor.
+	returning bool
+
+	// The destination of Goto and the path there.
+	goal          maths.Vec3
+	hasGoal       bool
+	path          []pathfind.Pos
+	pathComplete  bool
+	waypoint      int
+	sinceWaypoint int
+	failures      int
+
+	// The last values sent to the server.
+	sentPos      maths.Vec3
+	sentYaw      float32
+	sentPitch    float32
+	sentOnGround bool
+	sinceSent    int
+	// teleports counts the times the server placed the player.
+	teleports int
+}
+
+// sent is what a position packet tells the server.
+type sent struct {
+	pos        maths.Vec3
+	yaw, pitch float32
+	onGround   bool
+	moved      bool
+	// teleports is that of the Physics when the packet was made.
+	teleports int
+}
+
+// NewPhysics moves the player of c. Other entities in tracker push the
+// player, and each tick tells the tracker where the player is. tracker may
+// be nil.
+func NewPhysics(c *bot.Client, p *basic.Player, w *world.World, tracker *entities.Tracker) (*Physics, error) {
+	ph := &Physics{c: c, p: p, w: w, tracker: tracker}
+
+	// The position handler runs after the player's, which confirms the
+	// teleport.
+	_, err := c.Events.AddListener(
+		bot.PacketHandler{Priority: -1, ID: packetid.ClientboundLogin, F: ph.handleReset},
+		bot.PacketHandler{Priority: -1, ID: packetid.ClientboundRespawn, F: ph.handleReset},
+		bot.PacketHandler{Priority: -1, ID: packetid.ClientboundPlayerPosition, F: ph.handlePlayerPosition},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundSetHealth, F: ph.handleSetHealth},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundSetEntityMotion, F: ph.handleSetEntityMotion},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundExplode, F: ph.handleExplode},
+	)
+	if err != nil {
+		return nil, Error{err}
+	}
+
+	return ph, nil
+}
+
+// Run ticks the simulation TickRate times a second until ctx is done or
+// a position can't be sent.
+func (ph *Physics) Run(ctx context.Context) error {
+	ticker := time.NewTicker(time.Second / TickRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		err := ph.Tick()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Position returns where the simulation has the player.
+func (ph *Physics) Position() maths.Vec3 {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	return ph.pos
+}
+
+// Ready reports whether the server placed the player since it joined or
+// respawned, and it is alive.
+func (ph *Physics) Ready() bool {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	return ph.ready && ph.alive
+}
+
+func (ph *Physics) OnGround() bool {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	return ph.onGround
+}
+
+// Anchor returns the AFK spot the bot walks back to, false until the
+// server placed the player.
+func (ph *Physics) Anchor() (maths.Vec3, bool) {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	return ph.anchor, ph.hasAnchor
+}
+
+// SetAnchor moves the AFK spot.
+func (ph *Physics) SetAnchor(pos maths.Vec3) {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	ph.anchor, ph.hasAnchor, ph.returning = pos, true, false
+}
+
+// Tick advances the simulation by one tick, moves the player in the
+// tracker and sends the position if it changed.
+func (ph *Physics) Tick() error {
+	ph.mu.Lock()
+	p, s, ok := ph.step()
+	pos, ready := ph.pos, ph.ready
+	ph.mu.Unlock()
+
+	if ready && ph.tracker != nil {
+		err := ph.tracker.SetPosition(pos)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !ok {
+		return nil
+	}
+
+	// Writing may wait for room in the send queue, the handlers go on
+	// meanwhile.
+	err := ph.c.Conn.WritePacket(p)
+	if err != nil {
+		return Error{err}
+	}
+
+	ph.mu.Lock()
+	ph.setSent(s)
+	ph.mu.Unlock()
+
+	return nil
+}
+
+// step advances the simulation and returns the position packet to send,
+// false if there is none.
+func (ph *Physics) step() (pk.Packet, sent, bool) {
+	if !ph.ready {
+		return pk.Packet{}, sent{}, false
+	}
+
+	// Like the vanilla client the player stays put until the chunk it is
+	// in arrives.
+	if ph.alive && ph.w.IsLoaded(int(math.Floor(ph.pos.X)), int(math.Floor(ph.pos.Z))) {
+		walk, sink := false, false
+		if ph.hasGoal {
+			walk, sink = ph.followPath()
+		} else {
+			walk = ph.walkToAnchor()
+		}
+
+		ph.pushByEntities()
+		ph.travel(walk, sink)
+	}
+
+	return ph.positionPacket()
+}
+
+// walkToAnchor turns the player towards the anchor and returns whether it
+// should walk there. Once pushed further than anchorDrift it walks until
+// it is within anchorReach.
+func (ph *Physics) walkToAnchor() bool {
+	if !ph.hasAnchor {
+		return false
+	}
+
+	d := ph.anchor.Sub(ph.pos)
+	dist := math.Hypot(d.X, d.Z)
+
+	switch {
+	case dist > anchorDrift:
+		ph.returning = true
+	case dist <= anchorReach:
+		ph.returning = false
+	}
+
+	if !ph.returning {
+		return false
+	}
+
+	ph.yaw = float32(math.Atan2(-d.X, d.Z) * 180 / math.Pi)
+
+	return true
+}
+
+// positionPacket returns the packet telling the server what changed since
+// the last one, false if nothing did.
+func (ph *Physics) positionPacket() (pk.Packet, sent, bool) {
+	ph.sinceSent++
+
+	moved := ph.pos.Sub(ph.sentPos).Len() > 2e-4 || ph.sinceSent >= TickRate
+	rotated := ph.yaw != ph.sentYaw || ph.pitch != ph.sentPitch
+
+	var p pk.Packet
+	switch {
+	case moved && rotated:
+		p = pk.Marshal(
+			packetid.ServerboundMovePlayerPosRot,
+			pk.Double(ph.pos.X), pk.Double(ph.pos.Y), pk.Double(ph.pos.Z),
+			pk.Float(ph.yaw), pk.Float(ph.pitch),
+			pk.Boolean(ph.onGround),
+		)
+	case moved:
+		p = pk.Marshal(
+			packetid.ServerboundMovePlayerPos,
+			pk.Double(ph.pos.X), pk.Double(ph.pos.Y), pk.Double(ph.pos.Z),
+			pk.Boolean(ph.onGround),
+		)
+	case rotated:
+		p = pk.Marshal(
+			packetid.ServerboundMovePlayerRot,
+			pk.Float(ph.yaw), pk.Float(ph.pitch),
+			pk.Boolean(ph.onGround),
+		)
+	case ph.onGround != ph.sentOnGround:
+		p = pk.Marshal(packetid.ServerboundMovePlayerStatusOnly, pk.Boolean(ph.onGround))
+	default:
+		return pk.Packet{}, sent{}, false
+	}
+
+	return p, sent{ph.pos, ph.yaw, ph.pitch, ph.onGround, moved, ph.teleports}, true
+}
+
+// setSent records a position packet written. If the server placed the
+// player since it was made, the placement is what the server knows.
+func (ph *Physics) setSent(s sent) {
+	if s.teleports != ph.teleports {
+		return
+	}
+
+	if s.moved {
+		ph.sentPos, ph.sinceSent = s.pos, 0
+	}
+
+	ph.sentYaw, ph.sentPitch, ph.sentOnGround = s.yaw, s.pitch, s.onGround
+}
+
+// Reset stops the simulation until the server places the player again,
+// with a teleport, login or respawn. The anchor and the destination of
+// Goto are kept.
+func (ph *Physics) Reset() {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	ph.ready = false
+	ph.vel = maths.Vec3{}
+	ph.path = nil
+}
+
+func (ph *Physics) handleReset(pk.Packet) error {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	// Until the server places the player in the new world.
+	ph.ready = false
+	ph.alive = true
+	ph.vel = maths.Vec3{}
+	ph.hasGoal, ph.path = false, nil
+
+	return nil
+}
+
+func (ph *Physics) handlePlayerPosition(pk.Packet) error {
+	ph.mu.Lock()
+	defer ph.mu.Unlock()
+
+	ph.pos, ph.yaw, ph.pitch = ph.p.Position, ph.p.Yaw, ph.p.Pitch
+	ph.vel = maths.Vec3{}
+	ph.ready = true
+	// Find the way on from here.
+	ph.path = nil
+
+	// The player confirmed the teleport with this position.
+	ph.sentPos, ph.sentYaw, ph.sentPitch = ph.pos, ph.yaw, ph.pitch
+	ph.sentOnGround, ph.sinceSent = false, 0
+	ph.teleports++
+
+	if !ph.hasGoal && (!ph.hasAnchor || ph.pos.DistanceTo(ph.anchor) >= anchorMoved) {
+		ph.anchor, ph.hasAnchor, ph.returning = ph.pos, true, false
+	}
+
+	return nil
+}
+
+func (ph *Physics) handleSetHealth(p pk.Packet) error {
+	var health pk.Float
+	err := p.Scan(&health)
+	if err != nil {
+		return Error{err}
+	}
+
+	ph.mu.Lock()
+	ph.alive = health > 0
+	ph.mu.Unlock()
+
+	return nil
+}
+
+func (ph *Physics) handleSetEntityMotion(p pk.Packet) error {
+	var id pk.VarInt
+	var vx, vy, vz pk.Short
+
+	err := p.Scan(&id, &vx, &vy, &vz)
+	if err != nil {
+		return Error{err}
+	}
+
+	if int32(id) != ph.p.EID {
+		return nil
+	}
+
+	ph.mu.Lock()
+	ph.vel = maths.Vec3{X: float64(vx) / 8000, Y: float64(vy) / 8000, Z: float64(vz) / 8000}
+	ph.mu.Unlock()
+
+	return nil
+}
+
+// handleExplode applies the knockback of an explosion, which follows the
+// affected blocks in the packet.
+func (ph *Physics) handleExplode(p pk.Packet) error {
+	r := bytes.NewReader(p.Data)
+
+	var x, y, z pk.Double
+	var power pk.Float
+	var blocks pk.VarInt
+
+	_, err := pk.Tuple{&x, &y, &z, &power, &blocks}.ReadFrom(r)
+	if err != nil {
+		return Error{err}
+	}
+
+	// Each block is an offset of three bytes.
+	_, err = r.Seek(int64(blocks)*3, io.SeekCurrent)
+	if err != nil {
+		return Error{err}
+	}
+
+	var kx, ky, kz pk.Float
+	_, err = pk.Tuple{&kx, &ky, &kz}.ReadFrom(r)
+	if err != nil {
+		return Error{err}
+	}
+
+	ph.mu.Lock()
+	ph.vel = ph.vel.Add(maths.Vec3{X: float64(kx), Y: float64(ky), Z: float64(kz)})
+	ph.mu.Unlock()
+
+	return nil
+}
+
+type Error struct {
+	Err error
+}
+
+func (e Error) Error() string {
+	return "bot/physics: " + e.Err.Error()
+}
+
+func (e Error) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,193 @@
+package physics
+
+import (
+	"testing"
+
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/bot/world"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/maths"
+	pk "mcAfkGo/net/packet"
+)
+
+func newPhysics(t *testing.T) *Physics {
+	t.Helper()
+
+	c := bot.NewClient()
+	p, err := basic.NewPlayer(c, basic.DefaultSettings, basic.EventsListener{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := world.NewWorld(c, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ph, err := NewPhysics(c, p, w, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ph
+}
+
+// teleport places the player at pos as a player position packet would.
+func teleport(t *testing.T, ph *Physics, pos maths.Vec3) {
+	t.Helper()
+
+	ph.p.Position = pos
+	if err := ph.handlePlayerPosition(pk.Marshal(packetid.ClientboundPlayerPosition)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWalkToAnchor(t *testing.T) {
+	tests := []struct {
+		name string
+		// xs are the distances east of the anchor the player is pushed to
+		// on each tick, want whether it walks back on each.
+		xs   []float64
+		want []bool
+	}{
+		{name: "within drift", xs: []float64{0.1, 0.3, 0.5}, want: []bool{false, false, false}},
+		{name: "pushed away", xs: []float64{0.3, 0.6, 0.4, 0.26}, want: []bool{false, true, true, true}},
+		{name: "reached", xs: []float64{0.6, 0.25, 0.4}, want: []bool{true, false, false}},
+		{name: "pushed again", xs: []float64{0.6, 0.1, 0.7}, want: []bool{true, false, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ph := newPhysics(t)
+			teleport(t, ph, maths.Vec3{X: 0.5, Y: 64, Z: 0.5})
+
+			for i, x := range tt.xs {
+				ph.pos = ph.anchor.Add(maths.Vec3{X: x})
+
+				if got := ph.walkToAnchor(); got != tt.want[i] {
+					t.Fatalf("tick %d at %.2f blocks: walking %v, want %v", i, x, got, tt.want[i])
+				}
+
+				// Back to the anchor is west, a yaw of 90.
+				if tt.want[i] && ph.yaw != 90 {
+					t.Errorf("tick %d: yaw %v, want 90", i, ph.yaw)
+				}
+			}
+		})
+	}
+
+	t.Run("no anchor", func(t *testing.T) {
+		ph := newPhysics(t)
+		ph.pos = maths.Vec3{X: 100}
+
+		if ph.walkToAnchor() {
+			t.Error("walking without an anchor")
+		}
+	})
+}
+
+func TestSetSent(t *testing.T) {
+	start := maths.Vec3{X: 0.5, Y: 64, Z: 0.5}
+	moved := maths.Vec3{X: 1.5, Y: 64, Z: 0.5}
+	placed := maths.Vec3{X: 10.5, Y: 70, Z: 0.5}
+
+	tests := []struct {
+		name string
+		// teleport places the player between making and writing the
+		// packet.
+		teleport bool
+		want     maths.Vec3
+	}{
+		{name: "written", want: moved},
+		{name: "teleported meanwhile", teleport: true, want: placed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ph := newPhysics(t)
+			teleport(t, ph, start)
+
+			ph.pos, ph.yaw = moved, 45
+
+			p, s, ok := ph.positionPacket()
+			if !ok || p.ID != int32(packetid.ServerboundMovePlayerPosRot) {
+				t.Fatalf("packet %d, %v, want a position and rotation", p.ID, ok)
+			}
+
+			if tt.teleport {
+				teleport(t, ph, placed)
+			}
+
+			ph.setSent(s)
+
+			if ph.sentPos != tt.want {
+				t.Errorf("sent position %v, want %v", ph.sentPos, tt.want)
+			}
+
+			// Nothing is sent again unless the teleport moved the player
+			// away from what the packet said.
+			_, _, again := ph.positionPacket()
+			if again {
+				t.Errorf("position sent again")
+			}
+		})
+	}
+}
+
+func TestHandleExplode(t *testing.T) {
+	tests := []struct {
+		name   string
+		blocks [][3]int8
+	}{
+		{name: "no blocks"},
+		{name: "blocks", blocks: [][3]int8{{-1, 0, 1}, {2, -3, 4}, {0, 0, 0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ph := newPhysics(t)
+			ph.vel = maths.Vec3{Y: -0.5}
+
+			fields := []pk.FieldEncoder{pk.Double(1), pk.Double(64), pk.Double(1), pk.Float(4), pk.VarInt(len(tt.blocks))}
+			for _, b := range tt.blocks {
+				fields = append(fields, pk.Byte(b[0]), pk.Byte(b[1]), pk.Byte(b[2]))
+			}
+
+			fields = append(fields, pk.Float(0.5), pk.Float(0.25), pk.Float(-1))
+
+			err := ph.handleExplode(pk.Marshal(packetid.ClientboundExplode, fields...))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := maths.Vec3{X: 0.5, Y: -0.25, Z: -1}
+			if ph.vel != want {
+				t.Errorf("velocity %v, want %v", ph.vel, want)
+			}
+		})
+	}
+}
+
+func TestReset(t *testing.T) {
+	ph := newPhysics(t)
+	if err := ph.handleReset(pk.Marshal(packetid.ClientboundLogin)); err != nil {
+		t.Fatal(err)
+	}
+
+	teleport(t, ph, maths.Vec3{X: 0.5, Y: 64, Z: 0.5})
+	if !ph.Ready() {
+		t.Fatal("not ready after the login")
+	}
+
+	ph.Reset()
+	if ph.Ready() {
+		t.Fatal("ready after Reset")
+	}
+
+	// The next teleport places the player again.
+	teleport(t, ph, maths.Vec3{X: 3.5, Y: 64, Z: 0.5})
+	if !ph.Ready() {
+		t.Error("not ready after a teleport")
+	}
+}
@@ -0,0 +1,123 @@
+package physics
+
+import (
+	"math"
+
+	"mcAfkGo/bot/entities"
+	"mcAfkGo/data/block"
+	"mcAfkGo/maths"
+)
+
+const (
+	// waterPush scales the flow of the water the player is in.
+	waterPush = 0.014
+	// entityPush is how hard entities the player overlaps push it away.
+	entityPush = 0.05
+)
+
+// horizontal are the directions water flows in.
+var horizontal = [4][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}}
+
+// waterFlow returns the push of the water the player is in, the average
+// flow of the water blocks it overlaps.
+func (ph *Physics) waterFlow() maths.Vec3 {
+	box := ph.box()
+
+	x0, y0, z0 := box.Min.Floor()
+	x1, y1, z1 := box.Max.Floor()
+
+	var flow maths.Vec3
+	var n int
+
+	for x := x0; x <= x1; x++ {
+		for z := z0; z <= z1; z++ {
+			for y := y0; y <= y1; y++ {
+				state, ok := ph.w.BlockAt(x, y, z)
+				if !ok || !block.IsWater(state) {
+					continue
+				}
+
+				flow = flow.Add(ph.flowAt(x, y, z, state))
+				n++
+			}
+		}
+	}
+
+	if n == 0 {
+		return maths.Vec3{}
+	}
+
+	return flow.Scale(waterPush / float64(n))
+}
+
+// flowAt returns the direction water flows in at x, y, z: towards lower
+// water around it, and down where it falls.
+func (ph *Physics) flowAt(x, y, z int, state int32) maths.Vec3 {
+	own := block.FluidHeight(state)
+
+	var flow maths.Vec3
+	for _, d := range horizontal {
+		nx, nz := x+d[0], z+d[1]
+
+		other, ok := ph.w.BlockAt(nx, y, nz)
+		if !ok {
+			continue
+		}
+
+		var diff float64
+		switch {
+		case block.IsWater(other):
+			diff = own - block.FluidHeight(other)
+		case !block.HasCollision(other):
+			// Water running off an edge flows towards the water below.
+			below, ok := ph.w.BlockAt(nx, y-1, nz)
+			if !ok || !block.IsWater(below) {
+				continue
+			}
+
+			diff = own - (block.FluidHeight(below) - 8.0/9)
+		default:
+			continue
+		}
+
+		flow.X += float64(d[0]) * diff
+		flow.Z += float64(d[1]) * diff
+	}
+
+	if block.FluidLevel(state) >= 8 {
+		flow.Y -= 6
+	}
+
+	if l := flow.Len(); l > 0 {
+		flow = flow.Scale(1 / l)
+	}
+
+	return flow
+}
+
+// pushByEntities moves the player out of the entities it overlaps, like
+// the vanilla client pushes its player away from others.
+func (ph *Physics) pushByEntities() {
+	if ph.tracker == nil {
+		return
+	}
+
+	box := ph.box()
+	others := ph.tracker.Within(math.Inf(1), func(e *entities.Entity) bool {
+		return boxAt(e.Position).Intersects(box)
+	})
+
+	for _, e := range others {
+		dx, dz := ph.pos.X-e.Position.X, ph.pos.Z-e.Position.Z
+
+		d := max(math.Abs(dx), math.Abs(dz))
+		if d < 0.01 {
+			continue
+		}
+
+		d = math.Sqrt(d)
+		f := min(1, 1/d) * entityPush / d
+		ph.vel.X += dx * f
+		ph.vel.Z += dz * f
+	}
+}
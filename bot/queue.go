@@ -0,0 +1,161 @@
+package bot
+
+import (
+	"slices"
+
+	"mcAfkGo/data/packetid"
+	pk "mcAfkGo/net/packet"
+	"mcAfkGo/net/queue"
+)
+
+const (
+	// ReceiveQueueSize is the default capacity of the queue of packets
+	// received and not handled yet.
+	ReceiveQueueSize = 4096
+	// SendQueueSize is the default capacity of the queue of packets to
+	// send.
+	SendQueueSize = 1024
+)
+
+// NewReceiveQueue returns a queue for JoinOptions.QueueRead. Under
+// pressure it drops particles and sounds, and merges the movement of an
+// entity into its last queued one, instead of holding up the connection.
+// A movement after the entity was added or removed replaces the last one.
+func NewReceiveQueue(capacity int) *queue.RingQueue[pk.Packet] {
+	return queue.NewRingQueue(queue.RingOptions[pk.Packet]{
+		Capacity: capacity,
+		Policy: queue.Policy[pk.Packet]{
+			Droppable: droppable,
+			Key:       movingEntity,
+			Merge:     mergeMovement,
+			Resets:    resetsEntity,
+		},
+	})
+}
+
+// NewSendQueue returns a queue for JoinOptions.QueueWrite. Nothing is
+// dropped from it, a full one holds up the handler sending.
+func NewSendQueue(capacity int) *queue.RingQueue[pk.Packet] {
+	return queue.NewRingQueue(queue.RingOptions[pk.Packet]{Capacity: capacity})
+}
+
+func droppable(p pk.Packet) bool {
+	switch packetid.ClientboundPacketID(p.ID) {
+	case packetid.ClientboundLevelParticles,
+		packetid.ClientboundSound,
+		packetid.ClientboundSoundEntity:
+		return true
+	}
+
+	return false
+}
+
+// entityKey is the merge key of the movement of an entity.
+type entityKey int32
+
+// movingEntity returns the entity a movement packet is about.
+func movingEntity(p pk.Packet) (any, bool) {
+	switch packetid.ClientboundPacketID(p.ID) {
+	case packetid.ClientboundMoveEntityPos,
+		packetid.ClientboundMoveEntityPosRot,
+		packetid.ClientboundMoveEntityRot,
+		packetid.ClientboundRotateHead,
+		packetid.ClientboundTeleportEntity:
+	default:
+		return nil, false
+	}
+
+	var id pk.VarInt
+	if err := p.Scan(&id); err != nil {
+		return nil, false
+	}
+
+	return entityKey(id), true
+}
+
+// resetsEntity reports whether p adds or removes the entity of key, which
+// makes its earlier movements moot.
+func resetsEntity(p pk.Packet, key any) bool {
+	switch packetid.ClientboundPacketID(p.ID) {
+	case packetid.ClientboundAddEntity, packetid.ClientboundAddExperienceOrb:
+		var id pk.VarInt
+
+		return p.Scan(&id) == nil && entityKey(id) == key
+	case packetid.ClientboundRemoveEntities:
+		var ids []pk.VarInt
+		if p.Scan(pk.Array(&ids)) != nil {
+			return false
+		}
+
+		return slices.ContainsFunc(ids, func(id pk.VarInt) bool { return entityKey(id) == key })
+	}
+
+	return false
+}
+
+// mergeMovement merges two movements of the same kind. Relative moves add
+// up, the others replace the older one.
+func mergeMovement(older, newer pk.Packet) (pk.Packet, bool) {
+	if older.ID != newer.ID {
+		return pk.Packet{}, false
+	}
+
+	switch packetid.ClientboundPacketID(newer.ID) {
+	case packetid.ClientboundMoveEntityPos:
+		var (
+			id                  pk.VarInt
+			dx0, dy0, dz0       pk.Short
+			dx1, dy1, dz1       pk.Short
+			onGround0, onGround pk.Boolean
+		)
+
+		if older.Scan(&id, &dx0, &dy0, &dz0, &onGround0) != nil ||
+			newer.Scan(&id, &dx1, &dy1, &dz1, &onGround) != nil {
+			return pk.Packet{}, false
+		}
+
+		dx, dy, dz, ok := addDeltas(dx0, dy0, dz0, dx1, dy1, dz1)
+		if !ok {
+			return pk.Packet{}, false
+		}
+
+		return pk.Marshal(packetid.ClientboundMoveEntityPos, id, dx, dy, dz, onGround), true
+	case packetid.ClientboundMoveEntityPosRot:
+		var (
+			id                  pk.VarInt
+			dx0, dy0, dz0       pk.Short
+			dx1, dy1, dz1       pk.Short
+			yaw, pitch          pk.Angle
+			onGround0, onGround pk.Boolean
+		)
+
+		if older.Scan(&id, &dx0, &dy0, &dz0, &yaw, &pitch, &onGround0) != nil ||
+			newer.Scan(&id, &dx1, &dy1, &dz1, &yaw, &pitch, &onGround) != nil {
+			return pk.Packet{}, false
+		}
+
+		dx, dy, dz, ok := addDeltas(dx0, dy0, dz0, dx1, dy1, dz1)
+		if !ok {
+			return pk.Packet{}, false
+		}
+
+		return pk.Marshal(packetid.ClientboundMoveEntityPosRot, id, dx, dy, dz, yaw, pitch, onGround), true
+	}
+
+	return newer, true
+}
+
+// addDeltas adds two relative moves, ok is false if the sum doesn't fit.
+func addDeltas(dx0, dy0, dz0, dx1, dy1, dz1 pk.Short) (dx, dy, dz pk.Short, ok bool) {
+	add := func(a, b pk.Short) (pk.Short, bool) {
+		sum := int32(a) + int32(b)
+
+		return pk.Short(sum), sum == int32(int16(sum))
+	}
+
+	dx, okX := add(dx0, dx1)
+	dy, okY := add(dy0, dy1)
+	dz, okZ := add(dz0, dz1)
+
+	return dx, dy, dz, okX && okY && okZ
+}
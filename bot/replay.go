@@ -0,0 +1,89 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"mcAfkGo/data/packetid"
+	mcnet "mcAfkGo/net"
+	"mcAfkGo/net/capture"
+	pk "mcAfkGo/net/packet"
+)
+
+// Replay feeds the inbound play packets of a capture to the client's
+// handlers in order, as if a server sent them. Packets the handlers send
+// are dropped, so a session can be reproduced without a server. It stops
+// at the first handler error and reports the record that caused it.
+func (c *Client) Replay(r *capture.Reader) error {
+	conn := c.Conn
+	defer func() { c.Conn = conn }()
+
+	c.Conn = &Conn{
+		Conn: mcnet.WrapConn(replaySocket{}),
+		send: discardQueue{},
+		pool: sync.Pool{New: func() any { return []byte{} }},
+	}
+
+	for i := 0; ; i++ {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("replay: record %d: %w", i, err)
+		}
+
+		if rec.State != capture.StatePlay || rec.Direction != capture.Inbound {
+			continue
+		}
+
+		// Bundled packets are recorded one by one, the delimiters
+		// carry nothing to handle.
+		if rec.Packet.ID == int32(packetid.BundleDelimiter) {
+			continue
+		}
+
+		err = c.handlePacket(rec.Packet)
+		if err != nil {
+			return fmt.Errorf("replay: record %d at %s: %w", i, rec.Time.Format(time.RFC3339Nano), err)
+		}
+	}
+}
+
+type discardQueue struct{}
+
+func (discardQueue) Push(pk.Packet) bool { return true }
+
+func (discardQueue) Pull() (pk.Packet, bool) { return pk.Packet{}, false }
+
+func (discardQueue) Close() {}
+
+// replaySocket stands in for the network connection while replaying.
+type replaySocket struct{}
+
+func (replaySocket) Read([]byte) (int, error) { return 0, io.EOF }
+
+func (replaySocket) Write(b []byte) (int, error) { return len(b), nil }
+
+func (replaySocket) Close() error { return nil }
+
+func (replaySocket) LocalAddr() net.Addr { return replayAddr{} }
+
+func (replaySocket) RemoteAddr() net.Addr { return replayAddr{} }
+
+func (replaySocket) SetDeadline(time.Time) error { return nil }
+
+func (replaySocket) SetReadDeadline(time.Time) error { return nil }
+
+func (replaySocket) SetWriteDeadline(time.Time) error { return nil }
+
+type replayAddr struct{}
+
+func (replayAddr) Network() string { return "replay" }
+
+func (replayAddr) String() string { return "replay" }
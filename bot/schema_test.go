@@ -0,0 +1,48 @@
+package bot_test
+
+import (
+	"testing"
+
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/bot/entities"
+	"mcAfkGo/bot/physics"
+	"mcAfkGo/bot/world"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/net/capture"
+	"mcAfkGo/net/inspect"
+)
+
+// TestHandledPacketSchemas checks that every packet the handlers of the
+// bot read decodes by a schema, not as hex.
+func TestHandledPacketSchemas(t *testing.T) {
+	client := bot.NewClient()
+
+	player, err := basic.NewPlayer(client, basic.DefaultSettings, basic.EventsListener{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := world.NewWorld(client, player)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tracker, err := entities.NewTracker(client, player, entities.EventsListener{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = physics.NewPhysics(client, player, w, tracker)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The client reads the start of a configuration itself.
+	handled := append(client.Events.Handled(), packetid.ClientboundStartConfiguration)
+	for _, id := range handled {
+		if _, ok := inspect.Default.Lookup(capture.StatePlay, capture.Inbound, int32(id)); !ok {
+			t.Errorf("no schema for %v", id)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package world
+
+import "time"
+
+// batchSizeCalculator estimates how many chunks the bot can take per tick,
+// the way the vanilla client does: it averages the time a chunk of the
+// last batches took and asks for as many as fit in 7 ms of each tick.
+type batchSizeCalculator struct {
+	nanosPerChunk float64
+	samples       int
+}
+
+const (
+	batchTickBudget = float64(7 * time.Millisecond)
+	maxBatchSamples = 49
+)
+
+func newBatchSizeCalculator() batchSizeCalculator {
+	return batchSizeCalculator{nanosPerChunk: float64(2 * time.Millisecond), samples: 1}
+}
+
+func (b *batchSizeCalculator) finish(size int, took time.Duration) {
+	if size <= 0 {
+		return
+	}
+
+	// Outliers, like a batch that waited on a GC pause, only move the
+	// average by a factor of three.
+	perChunk := float64(took) / float64(size)
+	perChunk = min(max(perChunk, b.nanosPerChunk/3), b.nanosPerChunk*3)
+
+	b.nanosPerChunk = (b.nanosPerChunk*float64(b.samples) + perChunk) / float64(b.samples+1)
+	b.samples = min(b.samples+1, maxBatchSamples)
+}
+
+func (b *batchSizeCalculator) chunksPerTick() float32 {
+	return float32(batchTickBudget / b.nanosPerChunk)
+}
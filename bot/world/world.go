@@ -0,0 +1,389 @@
+// Package world keeps the chunks around the player up to date and answers
+// block queries.
+package world
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+	"time"
+
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/level"
+	"mcAfkGo/nbt"
+	pk "mcAfkGo/net/packet"
+)
+
+type World struct {
+	c *bot.Client
+	p *basic.Player
+
+	mu        sync.RWMutex
+	dimension string
+	minY      int32
+	columns   map[level.ChunkPos]*level.Chunk
+	center    level.ChunkPos
+	radius    int32
+
+	batchStart time.Time
+	batchSize  batchSizeCalculator
+}
+
+func NewWorld(c *bot.Client, p *basic.Player) (*World, error) {
+	w := &World{
+		c:         c,
+		p:         p,
+		columns:   make(map[level.ChunkPos]*level.Chunk),
+		batchSize: newBatchSizeCalculator(),
+	}
+
+	// The login and respawn handlers run after the player's, which parse
+	// the new dimension.
+	_, err := c.Events.AddListener(
+		bot.PacketHandler{Priority: -1, ID: packetid.ClientboundLogin, F: w.handleDimension},
+		bot.PacketHandler{Priority: -1, ID: packetid.ClientboundRespawn, F: w.handleDimension},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundLevelChunkWithLight, F: w.handleLevelChunk},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundForgetLevelChunk, F: w.handleForgetLevelChunk},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundBlockUpdate, F: w.handleBlockUpdate},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundSectionBlocksUpdate, F: w.handleSectionBlocksUpdate},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundBlockEntityData, F: w.handleBlockEntityData},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundSetChunkCacheCenter, F: w.handleSetChunkCacheCenter},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundSetChunkCacheRadius, F: w.handleSetChunkCacheRadius},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundChunkBatchStart, F: w.handleChunkBatchStart},
+		bot.PacketHandler{Priority: 0, ID: packetid.ClientboundChunkBatchFinished, F: w.handleChunkBatchFinished},
+	)
+	if err != nil {
+		return nil, Error{err}
+	}
+
+	return w, nil
+}
+
+// BlockAt returns the block state at x, y, z, false if its chunk isn't
+// loaded or y is outside the world.
+func (w *World) BlockAt(x, y, z int) (int32, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	s := w.section(x, y, z)
+	if s == nil {
+		return 0, false
+	}
+
+	return s.GetBlock(x&15, y&15, z&15), true
+}
+
+// BiomeAt returns the biome at x, y, z, false if its chunk isn't loaded.
+func (w *World) BiomeAt(x, y, z int) (int32, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	s := w.section(x, y, z)
+	if s == nil {
+		return 0, false
+	}
+
+	return s.GetBiome(x&15>>2, y&15>>2, z&15>>2), true
+}
+
+// BlockEntityAt returns the block entity at x, y, z.
+func (w *World) BlockEntityAt(x, y, z int) (level.BlockEntity, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	chunk := w.columns[level.ChunkPos{X: int32(x >> 4), Z: int32(z >> 4)}]
+	if chunk == nil {
+		return level.BlockEntity{}, false
+	}
+
+	xz := uint8(x&15<<4 | z&15)
+	for _, e := range chunk.BlockEntities {
+		if e.XZ == xz && int(e.Y) == y {
+			return e, true
+		}
+	}
+
+	return level.BlockEntity{}, false
+}
+
+// IsLoaded reports whether the chunk at the block x, z is loaded.
+func (w *World) IsLoaded(x, z int) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.columns[level.ChunkPos{X: int32(x >> 4), Z: int32(z >> 4)}] != nil
+}
+
+// LoadedChunks returns the number of loaded chunks.
+func (w *World) LoadedChunks() int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return len(w.columns)
+}
+
+// MinY returns the lowest y of the dimension the player is in.
+func (w *World) MinY() int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return int(w.minY)
+}
+
+// Reset drops the chunks, the server sends them again after the next login
+// or respawn.
+func (w *World) Reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	clear(w.columns)
+	w.dimension = ""
+}
+
+func (w *World) section(x, y, z int) *level.Section {
+	chunk := w.columns[level.ChunkPos{X: int32(x >> 4), Z: int32(z >> 4)}]
+	if chunk == nil {
+		return nil
+	}
+
+	i := (y - int(w.minY)) >> 4
+	if i < 0 || i >= len(chunk.Sections) {
+		return nil
+	}
+
+	return &chunk.Sections[i]
+}
+
+func (w *World) handleDimension(p pk.Packet) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.p.DimensionName != w.dimension {
+		clear(w.columns)
+		w.dimension = w.p.DimensionName
+	}
+
+	if p.ID == int32(packetid.ClientboundLogin) {
+		w.radius = w.p.ViewDistance
+	}
+
+	w.minY = 0
+	if t := w.c.Registries.DimensionType.GetByID(w.p.DimensionType); t != nil {
+		w.minY = t.MinY
+	}
+
+	return nil
+}
+
+func (w *World) handleLevelChunk(p pk.Packet) error {
+	var pos level.ChunkPos
+	chunk := new(level.Chunk)
+
+	err := p.Scan((*pk.Int)(&pos.X), (*pk.Int)(&pos.Z), chunk)
+	if err != nil {
+		return Error{err}
+	}
+
+	w.mu.Lock()
+	w.columns[pos] = chunk
+	w.mu.Unlock()
+
+	return nil
+}
+
+func (w *World) handleForgetLevelChunk(p pk.Packet) error {
+	var pos pk.Long
+	err := p.Scan(&pos)
+	if err != nil {
+		return Error{err}
+	}
+
+	w.mu.Lock()
+	delete(w.columns, level.ChunkPosFromLong(int64(pos)))
+	w.mu.Unlock()
+
+	return nil
+}
+
+func (w *World) handleBlockUpdate(p pk.Packet) error {
+	var pos pk.Position
+	var state pk.VarInt
+
+	err := p.Scan(&pos, &state)
+	if err != nil {
+		return Error{err}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if s := w.section(pos.X, pos.Y, pos.Z); s != nil {
+		s.SetBlock(pos.X&15, pos.Y&15, pos.Z&15, int32(state))
+	}
+
+	return nil
+}
+
+func (w *World) handleSectionBlocksUpdate(p pk.Packet) error {
+	var pos pk.Long
+	var blocks []pk.VarLong
+
+	err := p.Scan(&pos, pk.Array(&blocks))
+	if err != nil {
+		return Error{err}
+	}
+
+	sp := level.SectionPosFromLong(int64(pos))
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	s := w.section(int(sp.X)<<4, int(sp.Y)<<4, int(sp.Z)<<4)
+	if s == nil {
+		return nil
+	}
+
+	// Each entry is the state followed by 12 bits of x, z and y.
+	for _, b := range blocks {
+		s.SetBlock(int(b>>8&15), int(b&15), int(b>>4&15), int32(b>>12))
+	}
+
+	return nil
+}
+
+func (w *World) handleBlockEntityData(p pk.Packet) error {
+	var pos pk.Position
+	var e level.BlockEntity
+
+	err := p.Scan(&pos, (*pk.VarInt)(&e.Type), pk.NBTField{V: &e.Data, AllowUnknownFields: true})
+	if err != nil {
+		return Error{err}
+	}
+
+	e.XZ, e.Y = uint8(pos.X&15<<4|pos.Z&15), int16(pos.Y)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	chunk := w.columns[level.ChunkPos{X: int32(pos.X >> 4), Z: int32(pos.Z >> 4)}]
+	if chunk == nil {
+		return nil
+	}
+
+	// An empty tag means the block entity is gone.
+	removed := e.Data.Type == nbt.TagEnd ||
+		e.Data.Type == nbt.TagCompound && bytes.Equal(e.Data.Data, []byte{nbt.TagEnd})
+
+	for i, old := range chunk.BlockEntities {
+		if old.XZ != e.XZ || old.Y != e.Y {
+			continue
+		}
+
+		if removed {
+			chunk.BlockEntities = slices.Delete(chunk.BlockEntities, i, i+1)
+		} else {
+			chunk.BlockEntities[i] = e
+		}
+
+		return nil
+	}
+
+	if !removed {
+		chunk.BlockEntities = append(chunk.BlockEntities, e)
+	}
+
+	return nil
+}
+
+func (w *World) handleSetChunkCacheCenter(p pk.Packet) error {
+	var x, z pk.VarInt
+	err := p.Scan(&x, &z)
+	if err != nil {
+		return Error{err}
+	}
+
+	w.mu.Lock()
+	w.center = level.ChunkPos{X: int32(x), Z: int32(z)}
+	w.dropFarChunks()
+	w.mu.Unlock()
+
+	return nil
+}
+
+func (w *World) handleSetChunkCacheRadius(p pk.Packet) error {
+	var radius pk.VarInt
+	err := p.Scan(&radius)
+	if err != nil {
+		return Error{err}
+	}
+
+	w.mu.Lock()
+	w.radius = int32(radius)
+	w.dropFarChunks()
+	w.mu.Unlock()
+
+	return nil
+}
+
+// dropFarChunks unloads chunks the server would no longer update. Like the
+// vanilla client it keeps a margin of 3 chunks beyond the view distance.
+func (w *World) dropFarChunks() {
+	if w.radius <= 0 {
+		return
+	}
+
+	keep := max(w.radius, 2) + 3
+	for pos := range w.columns {
+		if abs(pos.X-w.center.X) > keep || abs(pos.Z-w.center.Z) > keep {
+			delete(w.columns, pos)
+		}
+	}
+}
+
+func abs(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+func (w *World) handleChunkBatchStart(pk.Packet) error {
+	w.batchStart = time.Now()
+
+	return nil
+}
+
+func (w *World) handleChunkBatchFinished(p pk.Packet) error {
+	var size pk.VarInt
+	err := p.Scan(&size)
+	if err != nil {
+		return Error{err}
+	}
+
+	w.batchSize.finish(int(size), time.Since(w.batchStart))
+
+	err = w.c.Conn.WritePacket(pk.Marshal(
+		packetid.ServerboundChunkBatchReceived,
+		pk.Float(w.batchSize.chunksPerTick()),
+	))
+	if err != nil {
+		return Error{err}
+	}
+
+	return nil
+}
+
+type Error struct {
+	Err error
+}
+
+func (e Error) Error() string {
+	return "bot/world: " + e.Err.Error()
+}
+
+func (e Error) Unwrap() error {
+	return e.Err
+}
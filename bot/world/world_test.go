@@ -0,0 +1,268 @@
+package world
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/level"
+	pk "mcAfkGo/net/packet"
+	"mcAfkGo/registry"
+)
+
+// chest is the data of a block entity.
+type chest struct {
+	CustomName string `nbt:"CustomName"`
+}
+
+// chunk encodes a level chunk packet at x, z with a section of a single
+// block state for each of states, the lowest first.
+func chunk(t *testing.T, x, z int32, states ...int32) pk.Packet {
+	t.Helper()
+
+	var sections bytes.Buffer
+	for _, state := range states {
+		_, err := pk.Tuple{
+			pk.Short(level.BlocksPerSection),
+			pk.UnsignedByte(0), pk.VarInt(state), pk.VarInt(0),
+			pk.UnsignedByte(0), pk.VarInt(0), pk.VarInt(0),
+		}.WriteTo(&sections)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return pk.Marshal(packetid.ClientboundLevelChunkWithLight,
+		pk.Int(x), pk.Int(z),
+		pk.NBTField{V: level.HeightMaps{}},
+		pk.ByteArray(sections.Bytes()),
+		pk.VarInt(1), pk.UnsignedByte(1<<4|2), pk.Short(3), pk.VarInt(7), pk.NBTField{V: chest{CustomName: "loaded"}},
+	)
+}
+
+func blockUpdate(x, y, z int, state int32) pk.Packet {
+	return pk.Marshal(packetid.ClientboundBlockUpdate, pk.Position{X: x, Y: y, Z: z}, pk.VarInt(state))
+}
+
+func blockEntity(x, y, z int, data any) pk.Packet {
+	return pk.Marshal(packetid.ClientboundBlockEntityData, pk.Position{X: x, Y: y, Z: z}, pk.VarInt(7), pk.NBTField{V: data})
+}
+
+func forget(x, z int32) pk.Packet {
+	return pk.Marshal(packetid.ClientboundForgetLevelChunk, pk.Long(int64(z)<<32|int64(uint32(x))))
+}
+
+func TestWorld(t *testing.T) {
+	// block is a query of BlockAt.
+	type block struct {
+		x, y, z int
+		state   int32
+		loaded  bool
+	}
+
+	tests := []struct {
+		name    string
+		packets func(t *testing.T) []pk.Packet
+		blocks  []block
+		// entity is the name of the chest at 1, 3, 2, "" for none.
+		entity string
+		chunks int
+	}{
+		{
+			name:    "chunk loaded",
+			packets: func(t *testing.T) []pk.Packet { return []pk.Packet{chunk(t, 0, 0, 1, 2), chunk(t, -1, -1, 3)} },
+			blocks: []block{
+				{x: 0, y: -64, z: 0, state: 1, loaded: true},
+				{x: 15, y: -49, z: 15, state: 1, loaded: true},
+				{x: 0, y: -48, z: 0, state: 2, loaded: true},
+				{x: -1, y: -64, z: -16, state: 3, loaded: true},
+				{x: 0, y: -65, z: 0},
+				{x: 0, y: -32, z: 0},
+				{x: 16, y: -64, z: 0},
+			},
+			entity: "loaded",
+			chunks: 2,
+		},
+		{
+			name: "chunk forgotten",
+			packets: func(t *testing.T) []pk.Packet {
+				return []pk.Packet{chunk(t, 0, 0, 1), chunk(t, -1, 0, 1), forget(-1, 0)}
+			},
+			blocks: []block{{x: 0, y: -64, z: 0, state: 1, loaded: true}, {x: -1, y: -64, z: 0}},
+			entity: "loaded",
+			chunks: 1,
+		},
+		{
+			name: "block updated",
+			packets: func(t *testing.T) []pk.Packet {
+				return []pk.Packet{chunk(t, 0, 0, 1, 1), blockUpdate(1, -60, 2, 5), blockUpdate(1, -40, 2, 6), blockUpdate(16, -64, 0, 5)}
+			},
+			blocks: []block{
+				{x: 1, y: -60, z: 2, state: 5, loaded: true},
+				{x: 2, y: -60, z: 1, state: 1, loaded: true},
+				{x: 1, y: -40, z: 2, state: 6, loaded: true},
+				{x: 16, y: -64, z: 0},
+			},
+			entity: "loaded",
+			chunks: 1,
+		},
+		{
+			name: "section updated",
+			packets: func(t *testing.T) []pk.Packet {
+				// The second section from the bottom, entries are the
+				// state and x, z, y.
+				pos := pk.Long(0<<42 | 0<<20 | -3&0xfffff)
+				return []pk.Packet{chunk(t, 0, 0, 1, 1), pk.Marshal(packetid.ClientboundSectionBlocksUpdate,
+					pos, pk.VarInt(2), pk.VarLong(9<<12|1<<8|2<<4|3), pk.VarLong(8<<12|15<<8|15<<4|15),
+				)}
+			},
+			blocks: []block{
+				{x: 1, y: -45, z: 2, state: 9, loaded: true},
+				{x: 15, y: -33, z: 15, state: 8, loaded: true},
+				{x: 1, y: -61, z: 2, state: 1, loaded: true},
+			},
+			entity: "loaded",
+			chunks: 1,
+		},
+		{
+			name: "block entity replaced",
+			packets: func(t *testing.T) []pk.Packet {
+				return []pk.Packet{chunk(t, 0, 0, 1), blockEntity(1, 3, 2, chest{CustomName: "replaced"})}
+			},
+			entity: "replaced",
+			chunks: 1,
+		},
+		{
+			name:    "block entity removed by an empty tag",
+			packets: func(t *testing.T) []pk.Packet { return []pk.Packet{chunk(t, 0, 0, 1), blockEntity(1, 3, 2, nil)} },
+			chunks:  1,
+		},
+		{
+			name: "block entity removed by an empty compound",
+			packets: func(t *testing.T) []pk.Packet {
+				return []pk.Packet{chunk(t, 0, 0, 1), blockEntity(1, 3, 2, struct{}{})}
+			},
+			chunks: 1,
+		},
+		{
+			name: "block entity added",
+			packets: func(t *testing.T) []pk.Packet {
+				return []pk.Packet{chunk(t, 0, 0, 1), blockEntity(1, 3, 2, nil), blockEntity(1, 3, 2, chest{CustomName: "added"})}
+			},
+			entity: "added",
+			chunks: 1,
+		},
+		{
+			name: "far chunks dropped",
+			packets: func(t *testing.T) []pk.Packet {
+				// The view distance is 2, chunks up to 5 away from the
+				// center are kept.
+				return []pk.Packet{
+					chunk(t, 0, 0, 1), chunk(t, 5, -5, 1), chunk(t, 6, 0, 1), chunk(t, 0, -6, 1), chunk(t, -5, 0, 1),
+					pk.Marshal(packetid.ClientboundSetChunkCacheCenter, pk.VarInt(1), pk.VarInt(0)),
+					pk.Marshal(packetid.ClientboundSetChunkCacheRadius, pk.VarInt(2)),
+				}
+			},
+			blocks: []block{
+				{x: 80, y: -64, z: -80, state: 1, loaded: true},
+				{x: 96, y: -64, z: 0, state: 1, loaded: true},
+				{x: 0, y: -64, z: -96},
+				{x: -80, y: -64, z: 0},
+			},
+			entity: "loaded",
+			chunks: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := bot.NewClient()
+			c.Registries.DimensionType.Put("minecraft:overworld", registry.DimensionType{MinY: -64, Height: 384})
+
+			p, err := basic.NewPlayer(c, basic.DefaultSettings, basic.EventsListener{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			w, err := NewWorld(c, p)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p.DimensionName = "minecraft:overworld"
+
+			handlers := map[packetid.ClientboundPacketID]func(pk.Packet) error{
+				packetid.ClientboundRespawn:             w.handleDimension,
+				packetid.ClientboundLevelChunkWithLight: w.handleLevelChunk,
+				packetid.ClientboundForgetLevelChunk:    w.handleForgetLevelChunk,
+				packetid.ClientboundBlockUpdate:         w.handleBlockUpdate,
+				packetid.ClientboundSectionBlocksUpdate: w.handleSectionBlocksUpdate,
+				packetid.ClientboundBlockEntityData:     w.handleBlockEntityData,
+				packetid.ClientboundSetChunkCacheCenter: w.handleSetChunkCacheCenter,
+				packetid.ClientboundSetChunkCacheRadius: w.handleSetChunkCacheRadius,
+			}
+
+			packets := append([]pk.Packet{pk.Marshal(packetid.ClientboundRespawn)}, tt.packets(t)...)
+			for _, p := range packets {
+				if err := handlers[packetid.ClientboundPacketID(p.ID)](p); err != nil {
+					t.Fatalf("packet %d: %v", p.ID, err)
+				}
+			}
+
+			if got := w.LoadedChunks(); got != tt.chunks {
+				t.Errorf("LoadedChunks = %d, want %d", got, tt.chunks)
+			}
+
+			for _, b := range tt.blocks {
+				state, loaded := w.BlockAt(b.x, b.y, b.z)
+				if state != b.state || loaded != b.loaded {
+					t.Errorf("BlockAt(%d, %d, %d) = %d, %v, want %d, %v", b.x, b.y, b.z, state, loaded, b.state, b.loaded)
+				}
+			}
+
+			e, ok := w.BlockEntityAt(1, 3, 2)
+			if ok != (tt.entity != "") {
+				t.Fatalf("BlockEntityAt = %v, want %q", ok, tt.entity)
+			}
+
+			if ok && (e.Type != 7 || !strings.Contains(e.Data.String(), "CustomName:"+tt.entity)) {
+				t.Errorf("block entity = %d %s, want %q", e.Type, e.Data, tt.entity)
+			}
+		})
+	}
+}
+
+func TestWorldDimensionChange(t *testing.T) {
+	c := bot.NewClient()
+
+	p, err := basic.NewPlayer(c, basic.DefaultSettings, basic.EventsListener{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewWorld(c, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	respawn := pk.Marshal(packetid.ClientboundRespawn)
+
+	p.DimensionName = "minecraft:overworld"
+	_ = w.handleDimension(respawn)
+	_ = w.handleLevelChunk(chunk(t, 0, 0, 1))
+
+	// Respawning in the same dimension keeps the chunks.
+	_ = w.handleDimension(respawn)
+	if !w.IsLoaded(0, 0) {
+		t.Fatal("chunk dropped on a respawn in the same dimension")
+	}
+
+	p.DimensionName = "minecraft:the_nether"
+	_ = w.handleDimension(respawn)
+	if w.IsLoaded(0, 0) {
+		t.Error("chunk kept on a dimension change")
+	}
+}
@@ -22,6 +22,39 @@ type Decoration struct {
 	} `nbt:"style,omitempty"`
 }
 
+// Decorate builds the message shown for content sent with chat type t, as
+// the client renders it: the translation key filled with the parameters.
+func (d Decoration) Decorate(content Message, t *Type) Message {
+	args := make(TranslateArgs, 0, len(d.Parameters))
+	for _, param := range d.Parameters {
+		switch param {
+		case "sender":
+			args = append(args, t.SenderName)
+		case "target":
+			if t.TargetName != nil {
+				args = append(args, *t.TargetName)
+			} else {
+				args = append(args, Text(""))
+			}
+		case "content":
+			args = append(args, content)
+		}
+	}
+
+	return Message{
+		Translate:     d.TranslationKey,
+		With:          args,
+		Bold:          d.Style.Bold,
+		Italic:        d.Style.Italic,
+		UnderLined:    d.Style.UnderLined,
+		StrikeThrough: d.Style.StrikeThrough,
+		Obfuscated:    d.Style.Obfuscated,
+		Color:         d.Style.Color,
+		Insertion:     d.Style.Insertion,
+		Font:          d.Style.Font,
+	}
+}
+
 type Type struct {
 	ID         int32
 	SenderName Message
@@ -48,8 +81,11 @@ func (t *Type) ReadFrom(r io.Reader) (n int64, err error) {
 	if hasTargetName {
 		t.TargetName = new(Message)
 		n4, err := t.TargetName.ReadFrom(r)
+		if err != nil {
+			return n1 + n2 + n3 + n4, fmt.Errorf("read target name error: %w", err)
+		}
 
-		return n1 + n2 + n3 + n4, fmt.Errorf("read target name error: %w", err)
+		return n1 + n2 + n3 + n4, nil
 	}
 
 	return n1 + n2 + n3, nil
@@ -0,0 +1,86 @@
+package chat
+
+import (
+	"bytes"
+	"testing"
+
+	pk "mcAfkGo/net/packet"
+)
+
+func TestDecorationDecorate(t *testing.T) {
+	target := Text("Red")
+
+	tests := []struct {
+		name       string
+		decoration Decoration
+		target     *Message
+		want       string
+	}{
+		{
+			name:       "chat",
+			decoration: Decoration{TranslationKey: "chat.type.text", Parameters: []string{"sender", "content"}},
+			want:       "<Steve> hello",
+		},
+		{
+			name:       "team without target",
+			decoration: Decoration{TranslationKey: "chat.type.team.text", Parameters: []string{"target", "sender", "content"}},
+			want:       " <Steve> hello",
+		},
+		{
+			name:       "team",
+			decoration: Decoration{TranslationKey: "chat.type.team.text", Parameters: []string{"target", "sender", "content"}},
+			target:     &target,
+			want:       "Red <Steve> hello",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ := Type{SenderName: Text("Steve"), TargetName: tt.target}
+
+			if got := tt.decoration.Decorate(Text("hello"), &typ).ClearString(); got != tt.want {
+				t.Errorf("Decorate = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypeReadFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []pk.FieldEncoder
+		want   string
+	}{
+		{name: "without target", fields: []pk.FieldEncoder{pk.VarInt(1), Text("Steve"), pk.Boolean(false)}},
+		{name: "with target", fields: []pk.FieldEncoder{pk.VarInt(1), Text("Steve"), pk.Boolean(true), Text("Red")}, want: "Red"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			for _, f := range tt.fields {
+				if _, err := f.WriteTo(&buf); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var typ Type
+			if _, err := typ.ReadFrom(&buf); err != nil {
+				t.Fatalf("ReadFrom: %v", err)
+			}
+
+			if typ.ID != 1 || typ.SenderName.ClearString() != "Steve" {
+				t.Errorf("ReadFrom = %d, %q, want 1, %q", typ.ID, typ.SenderName.ClearString(), "Steve")
+			}
+
+			var got string
+			if typ.TargetName != nil {
+				got = typ.TargetName.ClearString()
+			}
+
+			if got != tt.want || (typ.TargetName == nil) != (tt.want == "") {
+				t.Errorf("target name = %v, want %q", typ.TargetName, tt.want)
+			}
+		})
+	}
+}
@@ -22,12 +22,28 @@ func (m Message) TagType() byte {
 	return nbt.TagCompound
 }
 
+// MarshalNBT writes the compound payload only, the encoder calling it has
+// already written the tag type.
 func (m Message) MarshalNBT(w io.Writer) error {
+	var buf bytes.Buffer
+
+	enc := nbt.NewEncoder(&buf)
+	enc.NetworkFormat(true)
+
+	var err error
 	if m.Translate != "" {
-		return nbt.NewEncoder(w).Encode(translateMsg(m), "")
+		err = enc.Encode(translateMsg(m), "")
 	} else {
-		return nbt.NewEncoder(w).Encode(rawMsgStruct(m), "")
+		err = enc.Encode(rawMsgStruct(m), "")
 	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(buf.Bytes()[1:])
+
+	return err
 }
 
 func (m *Message) UnmarshalNBT(tagType byte, r nbt.DecoderReader) error {
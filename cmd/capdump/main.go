@@ -0,0 +1,81 @@
+// Command capdump prints the packets of a capture file written by a bot
+// with capture_file set, one decoded packet per line as JSON.
+//
+//	capdump [-state play] [-name keep_alive] capture.bin
+//
+// With -missing it lists the play packets it has no schema of instead,
+// which are printed as raw bytes with no_schema set.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"mcAfkGo/net/capture"
+	"mcAfkGo/net/inspect"
+)
+
+func main() {
+	state := flag.String("state", "", "only show packets of this state")
+	name := flag.String("name", "", "only show packets with this name")
+	missing := flag.Bool("missing", false, "list the play packets without a schema and exit")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] capture-file\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *missing {
+		for _, direction := range []capture.Direction{capture.Inbound, capture.Outbound} {
+			for _, name := range inspect.Missing(direction) {
+				fmt.Printf("%s\t%s\n", direction, name)
+			}
+		}
+
+		return
+	}
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer f.Close()
+
+	r, err := capture.NewReader(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		p := inspect.DecodeRecord(rec)
+		if *state != "" && p.State != *state || *name != "" && p.Name != *name {
+			continue
+		}
+
+		err = enc.Encode(p)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,523 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// blockReport is a block of blocks.json with its states.
+type blockReport struct {
+	States []struct {
+		ID         int32             `json:"id"`
+		Properties map[string]string `json:"properties"`
+	} `json:"states"`
+}
+
+// box is a part of a collision shape, in 1/16 block.
+type box [6]float64
+
+var fullCube = []box{{0, 0, 0, 16, 16, 16}}
+
+// passable are the blocks entities move through, besides those ending in
+// one of passableSuffixes.
+var passable = map[string]bool{
+	"air": true, "cave_air": true, "void_air": true, "water": true, "lava": true, "bubble_column": true,
+	"short_grass": true, "tall_grass": true, "fern": true, "large_fern": true, "dead_bush": true,
+	"seagrass": true, "tall_seagrass": true, "kelp": true, "kelp_plant": true, "sugar_cane": true,
+	"dandelion": true, "poppy": true, "blue_orchid": true, "allium": true, "azure_bluet": true,
+	"oxeye_daisy": true, "cornflower": true, "wither_rose": true, "lily_of_the_valley": true,
+	"torchflower": true, "sunflower": true, "lilac": true, "rose_bush": true, "peony": true,
+	"pitcher_plant": true, "pink_petals": true, "spore_blossom": true, "small_dripleaf": true,
+	"big_dripleaf_stem": true, "hanging_roots": true, "mangrove_propagule": true, "bamboo_sapling": true,
+	"brown_mushroom": true, "red_mushroom": true, "crimson_fungus": true, "warped_fungus": true,
+	"crimson_roots": true, "warped_roots": true, "nether_sprouts": true,
+	"wheat": true, "carrots": true, "potatoes": true, "beetroots": true, "nether_wart": true,
+	"torchflower_crop": true, "pitcher_crop": true, "sweet_berry_bush": true,
+	"melon_stem": true, "pumpkin_stem": true, "attached_melon_stem": true, "attached_pumpkin_stem": true,
+	"vine": true, "glow_lichen": true, "sculk_vein": true, "cave_vines": true, "cave_vines_plant": true,
+	"weeping_vines": true, "weeping_vines_plant": true, "twisting_vines": true, "twisting_vines_plant": true,
+	"cobweb": true, "fire": true, "soul_fire": true, "nether_portal": true, "end_portal": true,
+	"end_gateway": true, "redstone_wire": true, "tripwire": true, "tripwire_hook": true, "lever": true,
+	"structure_void": true, "light": true, "powder_snow": true, "moving_piston": true, "frogspawn": true,
+}
+
+var passableSuffixes = []string{
+	"_sapling", "_tulip", "_sign", "_banner", "_button", "_pressure_plate", "torch", "rail",
+	"_coral", "_coral_fan", "_coral_wall_fan",
+}
+
+// alwaysWater are the blocks that hold water without a waterlogged
+// property.
+var alwaysWater = map[string]bool{
+	"bubble_column": true, "kelp": true, "kelp_plant": true, "seagrass": true, "tall_seagrass": true,
+}
+
+// heights are the blocks whose shape is a single box of the full width,
+// by its height.
+var heights = map[string]float64{
+	"farmland": 15, "dirt_path": 15, "soul_sand": 14, "mud": 14,
+	"enchanting_table": 12, "end_portal_frame": 13, "daylight_detector": 6, "stonecutter": 9,
+	"campfire": 7, "soul_campfire": 7, "repeater": 2, "comparator": 2,
+	"sculk_sensor": 8, "calibrated_sculk_sensor": 8, "sculk_shrieker": 8, "moss_carpet": 1,
+}
+
+// shapes are the blocks of a fixed shape other than a full cube.
+var shapes = map[string][]box{
+	"chest":         {{1, 0, 1, 15, 14, 15}},
+	"trapped_chest": {{1, 0, 1, 15, 14, 15}},
+	"ender_chest":   {{1, 0, 1, 15, 14, 15}},
+	"cactus":        {{1, 0, 1, 15, 15, 15}},
+	"honey_block":   {{1, 0, 1, 15, 15, 15}},
+	"lily_pad":      {{1, 0, 1, 15, 1.5, 15}},
+	"flower_pot":    {{5, 0, 5, 11, 6, 11}},
+	"conduit":       {{5, 5, 5, 11, 11, 11}},
+	"heavy_core":    {{4, 0, 4, 12, 8, 12}},
+	"decorated_pot": {{1, 0, 1, 15, 16, 15}},
+	"bamboo":        {{6.5, 0, 6.5, 9.5, 16, 9.5}},
+	"brewing_stand": {{1, 0, 1, 15, 2, 15}, {7, 0, 7, 9, 14, 9}},
+	"scaffolding":   {{0, 14, 0, 16, 16, 16}},
+	"candle_cake":   {{1, 0, 1, 15, 8, 15}, {7, 8, 7, 9, 14, 9}},
+
+	"azalea":           {{0, 8, 0, 16, 16, 16}, {6, 0, 6, 10, 8, 10}},
+	"flowering_azalea": {{0, 8, 0, 16, 16, 16}, {6, 0, 6, 10, 8, 10}},
+}
+
+// blockShape returns the collision shape of a block state as the vanilla
+// server has it. Blocks without a rule are full cubes.
+func blockShape(name string, props map[string]string) []box {
+	if passable[name] || slices.ContainsFunc(passableSuffixes, func(s string) bool { return strings.HasSuffix(name, s) }) {
+		return nil
+	}
+
+	if h, ok := heights[name]; ok || strings.HasSuffix(name, "_carpet") {
+		if !ok {
+			h = 1
+		}
+
+		return []box{{0, 0, 0, 16, h, 16}}
+	}
+
+	if s, ok := shapes[name]; ok {
+		return s
+	}
+
+	switch {
+	case strings.HasPrefix(name, "potted_"):
+		return shapes["flower_pot"]
+	case strings.HasSuffix(name, "candle_cake"):
+		return shapes["candle_cake"]
+	case name == "cake":
+		bites, _ := strconv.Atoi(props["bites"])
+		return []box{{1 + float64(bites)*2, 0, 1, 15, 8, 15}}
+	case name == "snow":
+		layers, _ := strconv.Atoi(props["layers"])
+		if layers <= 1 {
+			return nil
+		}
+
+		return []box{{0, 0, 0, 16, float64(layers-1) * 2, 16}}
+	case strings.HasSuffix(name, "_slab"):
+		return slabShape(props["type"])
+	case strings.HasSuffix(name, "_stairs"):
+		return stairsShape(props["facing"], props["half"], props["shape"])
+	case strings.HasSuffix(name, "_trapdoor"):
+		return trapdoorShape(props["facing"], props["half"], props["open"] == "true")
+	case strings.HasSuffix(name, "_door"):
+		return doorShape(props["facing"], props["hinge"], props["open"] == "true")
+	case strings.HasSuffix(name, "_fence_gate"):
+		if props["open"] == "true" {
+			return nil
+		}
+
+		if props["facing"] == "north" || props["facing"] == "south" {
+			return []box{{0, 0, 6, 16, 24, 10}}
+		}
+
+		return []box{{6, 0, 0, 10, 24, 16}}
+	case strings.HasSuffix(name, "_fence"):
+		return crossShape(props, 2, 2, 24, true)
+	case name == "iron_bars" || strings.HasSuffix(name, "glass_pane"):
+		return crossShape(props, 1, 1, 16, true)
+	case strings.HasSuffix(name, "_wall"):
+		return crossShape(props, 4, 3, 24, props["up"] == "true")
+	case strings.HasSuffix(name, "_bed"):
+		return []box{{0, 0, 0, 16, 9, 16}}
+	case name == "ladder":
+		return panel(props["facing"], 3, 16)
+	case strings.HasSuffix(name, "_wall_skull") || strings.HasSuffix(name, "_wall_head"):
+		return []box{rotate(box{4, 4, 8, 12, 12, 16}, props["facing"])}
+	case strings.HasSuffix(name, "_skull") || strings.HasSuffix(name, "_head") && name != "piston_head":
+		return []box{{4, 0, 4, 12, 8, 12}}
+	case name == "lantern" || name == "soul_lantern":
+		if props["hanging"] == "true" {
+			return []box{{5, 1, 5, 11, 8, 11}, {6, 8, 6, 10, 10, 10}}
+		}
+
+		return []box{{5, 0, 5, 11, 7, 11}, {6, 7, 6, 10, 9, 10}}
+	case name == "chain" || name == "end_rod" || name == "lightning_rod":
+		width := 3.0
+		if name != "chain" {
+			width = 4
+		}
+
+		return []box{axisBox(props["axis"]+props["facing"], width)}
+	case name == "sea_pickle":
+		return [][]box{
+			{{6, 0, 6, 10, 6, 10}}, {{3, 0, 3, 13, 6, 13}}, {{2, 0, 2, 14, 6, 14}}, {{2, 0, 2, 14, 7, 14}},
+		}[max(0, min(3, atoi(props["pickles"])-1))]
+	case name == "turtle_egg":
+		if props["eggs"] == "1" {
+			return []box{{3, 0, 3, 12, 7, 12}}
+		}
+
+		return []box{{1, 0, 1, 15, 7, 15}}
+	case name == "big_dripleaf":
+		switch props["tilt"] {
+		case "full":
+			return nil
+		case "partial":
+			return []box{{0, 11, 0, 16, 13, 16}}
+		}
+
+		return []box{{0, 11, 0, 16, 15, 16}}
+	case strings.HasSuffix(name, "candle"):
+		return [][]box{
+			{{7, 0, 7, 9, 6, 9}}, {{5, 0, 6, 11, 6, 9}}, {{5, 0, 6, 10, 6, 11}}, {{5, 0, 5, 11, 6, 10}},
+		}[max(0, min(3, atoi(props["candles"])-1))]
+	}
+
+	return fullCube
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+
+	return n
+}
+
+func slabShape(typ string) []box {
+	switch typ {
+	case "bottom":
+		return []box{{0, 0, 0, 16, 8, 16}}
+	case "top":
+		return []box{{0, 8, 0, 16, 16, 16}}
+	}
+
+	return fullCube
+}
+
+// stairsShape builds stairs of the half slab and the step above or below
+// it, on the facing side. Outer corners keep a quarter of the step, inner
+// ones add a quarter to it.
+func stairsShape(facing, half, shape string) []box {
+	slabY, stepY := [2]float64{0, 8}, [2]float64{8, 16}
+	if half == "top" {
+		slabY, stepY = stepY, slabY
+	}
+
+	// quarter returns the quarter at the corner of the sides a and b.
+	quarter := func(a, b string) box {
+		q := box{0, stepY[0], 0, 16, stepY[1], 16}
+		for _, side := range []string{a, b} {
+			switch side {
+			case "north":
+				q[5] = 8
+			case "south":
+				q[2] = 8
+			case "west":
+				q[3] = 8
+			case "east":
+				q[0] = 8
+			}
+		}
+
+		return q
+	}
+
+	left, right, back := counterClockwise(facing), counterClockwise(counterClockwise(counterClockwise(facing))), counterClockwise(counterClockwise(facing))
+	boxes := []box{{0, slabY[0], 0, 16, slabY[1], 16}}
+
+	switch shape {
+	case "outer_left":
+		boxes = append(boxes, quarter(facing, left))
+	case "outer_right":
+		boxes = append(boxes, quarter(facing, right))
+	case "inner_left":
+		boxes = append(boxes, quarter(facing, ""), quarter(back, left))
+	case "inner_right":
+		boxes = append(boxes, quarter(facing, ""), quarter(back, right))
+	default:
+		boxes = append(boxes, quarter(facing, ""))
+	}
+
+	return boxes
+}
+
+func counterClockwise(facing string) string {
+	switch facing {
+	case "north":
+		return "west"
+	case "west":
+		return "south"
+	case "south":
+		return "east"
+	}
+
+	return "north"
+}
+
+func trapdoorShape(facing, half string, open bool) []box {
+	if !open {
+		if half == "top" {
+			return []box{{0, 13, 0, 16, 16, 16}}
+		}
+
+		return []box{{0, 0, 0, 16, 3, 16}}
+	}
+
+	// An open trapdoor lies against the side opposite its facing.
+	return panel(facing, 3, 16)
+}
+
+func doorShape(facing, hinge string, open bool) []box {
+	if open {
+		if hinge == "right" {
+			facing = counterClockwise(facing)
+		} else {
+			facing = counterClockwise(counterClockwise(counterClockwise(facing)))
+		}
+	}
+
+	return panel(facing, 3, 16)
+}
+
+// panel returns a vertical panel of the given thickness against the side
+// opposite facing, as of ladders and closed doors.
+func panel(facing string, thickness, height float64) []box {
+	switch facing {
+	case "north":
+		return []box{{0, 0, 16 - thickness, 16, height, 16}}
+	case "south":
+		return []box{{0, 0, 0, 16, height, thickness}}
+	case "west":
+		return []box{{16 - thickness, 0, 0, 16, height, 16}}
+	}
+
+	return []box{{0, 0, 0, thickness, height, 16}}
+}
+
+// rotate turns a box given for facing north to facing.
+func rotate(b box, facing string) box {
+	switch facing {
+	case "south":
+		return box{16 - b[3], b[1], 16 - b[5], 16 - b[0], b[4], 16 - b[2]}
+	case "west":
+		return box{b[2], b[1], 16 - b[3], b[5], b[4], 16 - b[0]}
+	case "east":
+		return box{16 - b[5], b[1], b[0], 16 - b[2], b[4], b[3]}
+	}
+
+	return b
+}
+
+// axisBox returns a rod of the given width through the middle of the
+// block along an axis or facing.
+func axisBox(axis string, width float64) box {
+	lo, hi := 8-width/2, 8+width/2
+	switch axis {
+	case "x", "east", "west":
+		return box{0, lo, lo, 16, hi, hi}
+	case "z", "north", "south":
+		return box{lo, lo, 0, hi, hi, 16}
+	}
+
+	return box{lo, 0, lo, hi, 16, hi}
+}
+
+// crossShape builds fences, panes and walls: a post in the middle and an
+// arm to each side the block connects to.
+func crossShape(props map[string]string, post, arm, height float64, hasPost bool) []box {
+	p0, p1 := 8-post, 8+post
+	a0, a1 := 8-arm, 8+arm
+
+	var boxes []box
+	if hasPost {
+		boxes = append(boxes, box{p0, 0, p0, p1, height, p1})
+	}
+
+	// Fences and panes connect with true, walls with low or tall.
+	connected := func(side string) bool {
+		v := props[side]
+		return v != "" && v != "false" && v != "none"
+	}
+
+	if connected("north") {
+		boxes = append(boxes, box{a0, 0, 0, a1, height, a1})
+	}
+
+	if connected("south") {
+		boxes = append(boxes, box{a0, 0, a0, a1, height, 16})
+	}
+
+	if connected("west") {
+		boxes = append(boxes, box{0, 0, a0, a1, height, a1})
+	}
+
+	if connected("east") {
+		boxes = append(boxes, box{a0, 0, a0, 16, height, a1})
+	}
+
+	return boxes
+}
+
+// blockTables are the generated tables of data/block.
+type blockTables struct {
+	Water, Lava [2]int32
+	// Shapes are the distinct shapes, ShapeRows the shape index of each
+	// state, formatted.
+	Shapes    []string
+	ShapeRows []string
+	// Waterlogged are the words of a bit set of the states holding water.
+	Waterlogged []string
+}
+
+func genBlocks(path, dir string) error {
+	var report map[string]blockReport
+	err := readJSON(path, &report)
+	if err != nil {
+		return err
+	}
+
+	// The states by ID, to number the shapes in a stable order.
+	type state struct {
+		name  string
+		props map[string]string
+	}
+
+	var count int
+	for _, b := range report {
+		count += len(b.States)
+	}
+
+	byID := make([]*state, count)
+	for name, b := range report {
+		for _, s := range b.States {
+			if s.ID < 0 || int(s.ID) >= count || byID[s.ID] != nil {
+				return fmt.Errorf("%s: bad state ID %d of %s", path, s.ID, name)
+			}
+
+			byID[s.ID] = &state{strings.TrimPrefix(name, "minecraft:"), s.Properties}
+		}
+	}
+
+	var tables blockTables
+	shapeIndex := make(map[string]int)
+	stateShapes := make([]int, count)
+	waterlogged := make([]uint64, (count+63)/64)
+
+	for id, s := range byID {
+		key := formatShape(blockShape(s.name, s.props))
+		i, ok := shapeIndex[key]
+		if !ok {
+			i = len(tables.Shapes)
+			shapeIndex[key] = i
+			tables.Shapes = append(tables.Shapes, key)
+		}
+
+		stateShapes[id] = i
+
+		if s.props["waterlogged"] == "true" || alwaysWater[s.name] {
+			waterlogged[id/64] |= 1 << (id % 64)
+		}
+	}
+
+	if len(tables.Shapes) > 256 {
+		return fmt.Errorf("%s: %d shapes don't fit a byte", path, len(tables.Shapes))
+	}
+
+	for i, fluid := range []string{"minecraft:water", "minecraft:lava"} {
+		states := report[fluid].States
+		if len(states) == 0 {
+			return fmt.Errorf("%s: no %s", path, fluid)
+		}
+
+		r := [2]int32{states[0].ID, states[len(states)-1].ID}
+		if int(r[1]-r[0]) != len(states)-1 {
+			return fmt.Errorf("%s: %s states are not contiguous", path, fluid)
+		}
+
+		if i == 0 {
+			tables.Water = r
+		} else {
+			tables.Lava = r
+		}
+	}
+
+	const perRow = 32
+	for i := 0; i < count; i += perRow {
+		var row []string
+		for _, v := range stateShapes[i:min(i+perRow, count)] {
+			row = append(row, strconv.Itoa(v))
+		}
+
+		tables.ShapeRows = append(tables.ShapeRows, strings.Join(row, ", ")+",")
+	}
+
+	for _, w := range waterlogged {
+		tables.Waterlogged = append(tables.Waterlogged, fmt.Sprintf("%#016x", w))
+	}
+
+	return writeGo(filepath.Join(dir, "states.go"), blocksTemplate, tables)
+}
+
+// formatShape writes a shape as a Go literal of boxes in blocks.
+func formatShape(boxes []box) string {
+	var parts []string
+	for _, b := range boxes {
+		var coords []string
+		for _, v := range b {
+			coords = append(coords, strconv.FormatFloat(v/16, 'g', -1, 64))
+		}
+
+		parts = append(parts, "{"+strings.Join(coords, ", ")+"}")
+	}
+
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+var blocksTemplate = template.Must(template.New("block").Parse(header + `package block
+
+// The states of the fluid blocks, one for each level.
+const (
+	waterMin = {{index .Water 0}}
+	waterMax = {{index .Water 1}}
+	lavaMin  = {{index .Lava 0}}
+	lavaMax  = {{index .Lava 1}}
+)
+
+// shapes are the distinct collision shapes.
+var shapes = [...][]Box{
+{{- range .Shapes}}
+	{{.}},
+{{- end}}
+}
+
+// stateShapes are the indices into shapes of the states.
+var stateShapes = [...]uint8{
+{{- range .ShapeRows}}
+	{{.}}
+{{- end}}
+}
+
+// waterlogged is a bit set of the states that hold water besides their
+// block.
+var waterlogged = [...]uint64{
+{{- range .Waterlogged}}
+	{{.}},
+{{- end}}
+}
+`))
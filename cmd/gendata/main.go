@@ -0,0 +1,306 @@
+// Command gendata generates the packet ID constants and registry tables in
+// data from the JSON reports of the vanilla server's data generator:
+//
+//	java -DbundlerMainClass=net.minecraft.data.Main -jar server.jar --reports
+//	gendata -reports generated/reports -out data
+//
+// It writes packetid/packetid.go and packetid/packetid_string.go from
+// packets.json and, if the reports have them, registryid/registryid.go from
+// registries.json and block/states.go from blocks.json. data/reports keeps
+// the reports the tables were last generated from, except blocks.json,
+// which is too large to keep.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	reports := flag.String("reports", "", "directory of the data generator reports")
+	out := flag.String("out", "data", "directory of the packetid, registryid and block packages")
+	flag.Parse()
+
+	if *reports == "" {
+		log.Fatal("gendata: -reports not set, point it at the generated/reports directory; go generate ./data/packetid uses data/reports")
+	}
+
+	err := genPackets(filepath.Join(*reports, "packets.json"), filepath.Join(*out, "packetid"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = genRegistries(filepath.Join(*reports, "registries.json"), filepath.Join(*out, "registryid"))
+	if errors.Is(err, os.ErrNotExist) {
+		log.Print("gendata: no registries.json, skipping registryid")
+	} else if err != nil {
+		log.Fatal(err)
+	}
+
+	err = genBlocks(filepath.Join(*reports, "blocks.json"), filepath.Join(*out, "block"))
+	if errors.Is(err, os.ErrNotExist) {
+		log.Print("gendata: no blocks.json, skipping block")
+	} else if err != nil {
+		log.Fatal(err)
+	}
+}
+
+type entry struct {
+	ProtocolID int32 `json:"protocol_id"`
+}
+
+// packet is a packet of one state and direction, named after its constant.
+type packet struct {
+	Const string
+	ID    int32
+}
+
+type packetBlock struct {
+	Type    string
+	Packets []packet
+	Guard   string
+}
+
+// states lists the states in the order their constants are written, with
+// the prefix of their constant names. Handshake packets have no constants.
+var states = []struct{ name, prefix string }{
+	{"login", "Login"},
+	{"status", "Status"},
+	{"configuration", "Config"},
+	{"play", ""},
+}
+
+func genPackets(path, dir string) error {
+	var report map[string]map[string]map[string]entry
+	err := readJSON(path, &report)
+	if err != nil {
+		return err
+	}
+
+	var blocks []packetBlock
+	names := make(map[string][]string)
+
+	for _, state := range states {
+		for _, direction := range []string{"clientbound", "serverbound"} {
+			packets, ok := report[state.name][direction]
+			if !ok {
+				return fmt.Errorf("%s: no %s %s packets", path, state.name, direction)
+			}
+
+			block, err := newPacketBlock(state.name, state.prefix, direction, packets)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			if state.name == "play" {
+				block.Guard = block.Type + "Guard"
+				for _, p := range block.Packets {
+					names[block.Type] = append(names[block.Type], p.Const)
+				}
+			}
+
+			blocks = append(blocks, block)
+		}
+	}
+
+	err = writeGo(filepath.Join(dir, "packetid.go"), packetsTemplate, blocks)
+	if err != nil {
+		return err
+	}
+
+	return writeGo(filepath.Join(dir, "packetid_string.go"), stringTemplate, []struct {
+		Type  string
+		Names []string
+	}{
+		{"ClientboundPacketID", names["ClientboundPacketID"]},
+		{"ServerboundPacketID", names["ServerboundPacketID"]},
+	})
+}
+
+func newPacketBlock(state, prefix, direction string, packets map[string]entry) (packetBlock, error) {
+	block := packetBlock{Type: camel(direction) + "PacketID"}
+
+	for key, e := range packets {
+		name := camel(strings.TrimPrefix(key, "minecraft:"))
+
+		// The bundle delimiter is the only packet whose name lacks the
+		// direction, as in the game's own naming.
+		c := camel(direction) + prefix + name
+		if state == "play" && name == "BundleDelimiter" {
+			c = name
+		}
+
+		block.Packets = append(block.Packets, packet{Const: c, ID: e.ProtocolID})
+	}
+
+	slices.SortFunc(block.Packets, func(a, b packet) int { return int(a.ID - b.ID) })
+
+	for i, p := range block.Packets {
+		if p.ID != int32(i) {
+			return block, fmt.Errorf("%s %s packet IDs are not contiguous at %s", state, direction, p.Const)
+		}
+	}
+
+	return block, nil
+}
+
+type registry struct {
+	Key     string
+	Var     string
+	Entries []string
+}
+
+func genRegistries(path, dir string) error {
+	var report map[string]struct {
+		Entries map[string]entry `json:"entries"`
+	}
+	err := readJSON(path, &report)
+	if err != nil {
+		return err
+	}
+
+	var registries []registry
+	for key, r := range report {
+		entries := make([]string, len(r.Entries))
+		for name, e := range r.Entries {
+			if e.ProtocolID < 0 || int(e.ProtocolID) >= len(entries) || entries[e.ProtocolID] != "" {
+				return fmt.Errorf("%s: %s: bad protocol ID %d of %s", path, key, e.ProtocolID, name)
+			}
+
+			entries[e.ProtocolID] = name
+		}
+
+		registries = append(registries, registry{
+			Key:     key,
+			Var:     camel(strings.TrimPrefix(key, "minecraft:")),
+			Entries: entries,
+		})
+	}
+
+	slices.SortFunc(registries, func(a, b registry) int { return strings.Compare(a.Key, b.Key) })
+
+	err = os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	return writeGo(filepath.Join(dir, "registryid.go"), registriesTemplate, registries)
+}
+
+// camel turns names like worldgen/biome_source into WorldgenBiomeSource.
+func camel(s string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '/' || r == '.' }) {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	return b.String()
+}
+
+func readJSON(path string, v any) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(b, v)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+func writeGo(path string, t *template.Template, data any) error {
+	var buf bytes.Buffer
+	err := t.Execute(&buf, data)
+	if err != nil {
+		return err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format %s: %w", path, err)
+	}
+
+	return os.WriteFile(path, src, 0o644)
+}
+
+const header = "// Code generated by gendata from the vanilla data reports; DO NOT EDIT.\n\n"
+
+var packetsTemplate = template.Must(template.New("packetid").Parse(header + `package packetid
+
+type (
+	ClientboundPacketID int32
+	ServerboundPacketID int32
+)
+{{range .}}{{$type := .Type}}
+const (
+{{- range $i, $p := .Packets}}
+	{{$p.Const}}{{if eq $i 0}} {{$type}} = iota{{end}}
+{{- end}}
+{{- if .Guard}}
+	{{.Guard}}
+{{- end}}
+)
+{{end}}`))
+
+var stringTemplate = template.Must(template.New("string").Parse(header + `package packetid
+
+import "strconv"
+{{range .}}
+// String returns the name of the play state packet with the ID. The IDs of
+// the other states overlap them.
+func (i {{.Type}}) String() string {
+	if i < 0 || int(i) >= len(_{{.Type}}_names) {
+		return "{{.Type}}(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+
+	return _{{.Type}}_names[i]
+}
+
+var _{{.Type}}_names = [...]string{
+{{- range .Names}}
+	"{{.}}",
+{{- end}}
+}
+{{end}}`))
+
+var registriesTemplate = template.Must(template.New("registryid").Parse(header + `// Package registryid lists the entries of the game's built-in registries,
+// indexed by their network ID.
+package registryid
+
+import "slices"
+
+// ID returns the network ID of the named entry of a registry, or -1.
+func ID(entries []string, name string) int32 {
+	return int32(slices.Index(entries, name))
+}
+
+var (
+{{- range .}}
+	{{.Var}} = []string{
+	{{- range .Entries}}
+		"{{.}}",
+	{{- end}}
+	}
+{{end}}
+)
+
+// Registries maps registry names to their entries.
+var Registries = map[string][]string{
+{{- range .}}
+	"{{.Key}}": {{.Var}},
+{{- end}}
+}
+`))
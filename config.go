@@ -0,0 +1,328 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"mcAfkGo/auth"
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/data/protocol"
+)
+
+const (
+	PacketErrorsSkip      = "skip"
+	PacketErrorsReset     = "reset"
+	PacketErrorsReconnect = "reconnect"
+)
+
+const (
+	AuthMicrosoft = "microsoft"
+	AuthToken     = "token"
+	AuthOffline   = "offline"
+)
+
+type BotConfig struct {
+	ID       string          `json:"id"`
+	Address  string          `json:"address"`
+	Settings json.RawMessage `json:"settings,omitempty"`
+
+	// Auth selects how the bot logs in: "microsoft" (the default) uses the
+	// device code flow, "token" a pre-supplied access token and "offline"
+	// joins servers running with online-mode=false.
+	Auth        string `json:"auth,omitempty"`
+	ClientID    string `json:"client_id,omitempty"`
+	TokenFile   string `json:"token_file,omitempty"`
+	Username    string `json:"username,omitempty"`
+	UUID        string `json:"uuid,omitempty"`
+	AccessToken string `json:"access_token,omitempty"`
+
+	// TokenKeyFile holds the key encrypting the token cache. It defaults to
+	// the MS_TOKEN_KEY and MS_TOKEN_KEY_FILE environment variables.
+	TokenKeyFile string `json:"token_key_file,omitempty"`
+	tokenKey     []byte
+
+	// AuthEndpoints overrides the services used to log in, e.g. the
+	// session server of an authlib-injector compatible auth server.
+	AuthEndpoints auth.Endpoints `json:"auth_endpoints"`
+
+	// CaptureFile, if set, records every packet the bot sends and receives.
+	// The file is rotated at CaptureMaxBytes, keeping CaptureMaxFiles old
+	// ones.
+	CaptureFile     string `json:"capture_file,omitempty"`
+	CaptureMaxBytes int64  `json:"capture_max_bytes,omitempty"`
+	CaptureMaxFiles int    `json:"capture_max_files,omitempty"`
+
+	// DebugPackets keeps the last DebugPackets packets for the
+	// /bots/{id}/debug/packets endpoint, DebugLog logs every packet.
+	DebugPackets int  `json:"debug_packets,omitempty"`
+	DebugLog     bool `json:"debug_log,omitempty"`
+
+	// HostileRadius, if set, logs hostile mobs coming within that many
+	// blocks of the bot.
+	HostileRadius float64 `json:"hostile_radius,omitempty"`
+
+	// DeathsFile keeps the bot's deaths, a line of JSON each, so the
+	// deaths endpoint still has them after a restart. It defaults to the
+	// bot's ID with .deaths.jsonl appended.
+	DeathsFile string `json:"deaths_file,omitempty"`
+
+	// RecoveryCommand is run after the bot respawned away from its AFK
+	// spot, e.g. "/home afk". Without it, or if it doesn't get the bot
+	// back, the bot walks. It tries RecoveryAttempts times.
+	RecoveryCommand  string `json:"recovery_command,omitempty"`
+	RecoveryAttempts int    `json:"recovery_attempts,omitempty"`
+
+	// PacketErrors tells what a packet the bot fails on does: "skip" (the
+	// default) drops it and goes on, unless there were more than
+	// MaxPacketErrors in a minute, "reset" also drops the world and the
+	// entities and stands still until the server places the bot again, and
+	// "reconnect" starts a new session.
+	PacketErrors    string `json:"packet_errors,omitempty"`
+	MaxPacketErrors int    `json:"max_packet_errors,omitempty"`
+
+	// ReceiveQueue and SendQueue are the capacities, in packets, of the
+	// queues between the connection and the bot.
+	ReceiveQueue int `json:"receive_queue,omitempty"`
+	SendQueue    int `json:"send_queue,omitempty"`
+
+	// Protocol pins the protocol version to speak. Without it the bot
+	// speaks the version the server reports, if supported.
+	Protocol int32 `json:"protocol,omitempty"`
+}
+
+const (
+	defaultCaptureMaxBytes = 64 << 20
+	defaultCaptureMaxFiles = 5
+	defaultMaxPacketErrors = 10
+)
+
+type Config struct {
+	Bots []BotConfig `json:"bots"`
+}
+
+// loadConfig reads the bot definitions from the JSON file named by
+// BOTS_CONFIG. Without it a single bot is built from the MC_ADDRESS,
+// MC_AUTH, MS_CLIENT_ID, MS_TOKEN_FILE, MC_USERNAME, MC_UUID,
+// MC_ACCESS_TOKEN, MC_SESSION_SERVER, MC_CAPTURE_FILE, MC_DEBUG_PACKETS,
+// MC_DEBUG_LOG, MC_RECOVERY_COMMAND and MC_DEATHS_FILE environment
+// variables.
+func loadConfig() (*Config, error) {
+	path := getEnv("BOTS_CONFIG", "")
+	if path == "" {
+		bc := BotConfig{
+			ID:          "default",
+			Address:     getEnv("MC_ADDRESS", "127.0.0.1:25565"),
+			Auth:        getEnv("MC_AUTH", AuthMicrosoft),
+			ClientID:    getEnv("MS_CLIENT_ID", ""),
+			TokenFile:   getEnv("MS_TOKEN_FILE", "token.mctoken"),
+			Username:    getEnv("MC_USERNAME", ""),
+			UUID:        getEnv("MC_UUID", ""),
+			AccessToken: getEnv("MC_ACCESS_TOKEN", ""),
+			AuthEndpoints: auth.Endpoints{
+				SessionServer: getEnv("MC_SESSION_SERVER", ""),
+			},
+			CaptureFile:     getEnv("MC_CAPTURE_FILE", ""),
+			DebugLog:        getEnv("MC_DEBUG_LOG", "") == "true",
+			RecoveryCommand: getEnv("MC_RECOVERY_COMMAND", ""),
+			DeathsFile:      getEnv("MC_DEATHS_FILE", "deaths.jsonl"),
+		}
+
+		if v := getEnv("MC_DEBUG_PACKETS", ""); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("MC_DEBUG_PACKETS: %w", err)
+			}
+
+			bc.DebugPackets = n
+		}
+
+		if bc.Auth == AuthMicrosoft && bc.ClientID == "" {
+			return nil, errors.New("MS_CLIENT_ID environment variable must be set. Get one from Azure AD app registration.")
+		}
+
+		cfg := &Config{Bots: []BotConfig{bc}}
+
+		return cfg, cfg.validate()
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	err = json.Unmarshal(b, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	err = cfg.validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+func (c *Config) validate() error {
+	if len(c.Bots) == 0 {
+		return errors.New("no bots configured")
+	}
+
+	seen := make(map[string]bool, len(c.Bots))
+	// tokenFiles are the bots by the token cache they use. Two bots can't
+	// share one, each would overwrite the other's tokens.
+	tokenFiles := make(map[string]string, len(c.Bots))
+
+	for i := range c.Bots {
+		bc := &c.Bots[i]
+		if bc.ID == "" {
+			return fmt.Errorf("bots[%d]: missing id", i)
+		}
+
+		if seen[bc.ID] {
+			return fmt.Errorf("bots[%d]: duplicate id %q", i, bc.ID)
+		}
+
+		seen[bc.ID] = true
+
+		if bc.Address == "" {
+			return fmt.Errorf("bot %q: missing address", bc.ID)
+		}
+
+		switch bc.Auth {
+		case "", AuthMicrosoft:
+			bc.Auth = AuthMicrosoft
+			if bc.ClientID == "" {
+				return fmt.Errorf("bot %q: missing client_id", bc.ID)
+			}
+
+			if bc.TokenFile == "" {
+				bc.TokenFile = bc.ID + ".mctoken"
+			}
+
+			tokenFile := filepath.Clean(bc.TokenFile)
+			if other, ok := tokenFiles[tokenFile]; ok {
+				return fmt.Errorf("bot %q: token_file %q is used by bot %q too", bc.ID, bc.TokenFile, other)
+			}
+
+			tokenFiles[tokenFile] = bc.ID
+
+			var err error
+			if bc.TokenKeyFile != "" {
+				bc.tokenKey, err = auth.LoadTokenKey("", bc.TokenKeyFile)
+			} else {
+				bc.tokenKey, err = auth.LoadTokenKey(getEnv("MS_TOKEN_KEY", ""), getEnv("MS_TOKEN_KEY_FILE", ""))
+			}
+
+			if err != nil {
+				return fmt.Errorf("bot %q: %w", bc.ID, err)
+			}
+
+		case AuthToken:
+			if bc.AccessToken == "" || bc.Username == "" || bc.UUID == "" {
+				return fmt.Errorf("bot %q: token auth needs access_token, username and uuid", bc.ID)
+			}
+
+		case AuthOffline:
+			if bc.Username == "" {
+				return fmt.Errorf("bot %q: offline auth needs a username", bc.ID)
+			}
+
+		default:
+			return fmt.Errorf("bot %q: unknown auth %q", bc.ID, bc.Auth)
+		}
+
+		if _, ok := protocol.Lookup(bc.Protocol); bc.Protocol != 0 && !ok {
+			var supported []string
+			for _, v := range protocol.Supported() {
+				supported = append(supported, v.String())
+			}
+
+			return fmt.Errorf("bot %q: unsupported protocol %d, supported are %s", bc.ID, bc.Protocol, strings.Join(supported, ", "))
+		}
+
+		if bc.CaptureMaxBytes == 0 {
+			bc.CaptureMaxBytes = defaultCaptureMaxBytes
+		}
+
+		if bc.CaptureMaxFiles == 0 {
+			bc.CaptureMaxFiles = defaultCaptureMaxFiles
+		}
+
+		if bc.DeathsFile == "" {
+			bc.DeathsFile = bc.ID + ".deaths.jsonl"
+		}
+
+		switch {
+		case bc.RecoveryAttempts < 0:
+			return fmt.Errorf("bot %q: negative recovery_attempts %d", bc.ID, bc.RecoveryAttempts)
+		case bc.RecoveryAttempts == 0:
+			bc.RecoveryAttempts = defaultRecoveryAttempts
+		}
+
+		switch bc.PacketErrors {
+		case "":
+			bc.PacketErrors = PacketErrorsSkip
+		case PacketErrorsSkip, PacketErrorsReset, PacketErrorsReconnect:
+		default:
+			return fmt.Errorf("bot %q: unknown packet_errors %q", bc.ID, bc.PacketErrors)
+		}
+
+		if bc.MaxPacketErrors == 0 {
+			bc.MaxPacketErrors = defaultMaxPacketErrors
+		}
+
+		if bc.ReceiveQueue == 0 {
+			bc.ReceiveQueue = bot.ReceiveQueueSize
+		}
+
+		if bc.SendQueue == 0 {
+			bc.SendQueue = bot.SendQueueSize
+		}
+	}
+
+	return nil
+}
+
+func (bc BotConfig) authenticator() auth.Authenticator {
+	client := auth.NewClient(bc.AuthEndpoints)
+	client.CacheKey = bc.tokenKey
+
+	switch bc.Auth {
+	case AuthToken:
+		return auth.NewTokenAuthenticator(client, auth.Profile{
+			Name:        bc.Username,
+			UUID:        bc.UUID,
+			AccessToken: bc.AccessToken,
+		})
+
+	case AuthOffline:
+		return auth.NewOfflineAuthenticator(bc.Username)
+
+	default:
+		return auth.NewMicrosoftAuthenticator(client, bc.ClientID, bc.TokenFile)
+	}
+}
+
+// settings returns basic.DefaultSettings overridden by the fields given in
+// the bot's "settings" object.
+func (bc BotConfig) settings() (basic.Settings, error) {
+	settings := basic.DefaultSettings
+	if len(bc.Settings) == 0 {
+		return settings, nil
+	}
+
+	err := json.Unmarshal(bc.Settings, &settings)
+	if err != nil {
+		return settings, fmt.Errorf("bot %q: parse settings: %w", bc.ID, err)
+	}
+
+	return settings, nil
+}
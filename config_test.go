@@ -0,0 +1,67 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConfigValidate(t *testing.T) {
+	microsoft := func(id, tokenFile string) BotConfig {
+		return BotConfig{ID: id, Address: "localhost", ClientID: "client", TokenFile: tokenFile}
+	}
+
+	tests := []struct {
+		name    string
+		bots    []BotConfig
+		wantErr string
+	}{
+		{
+			name: "own token files",
+			bots: []BotConfig{microsoft("a", ""), microsoft("b", ""), microsoft("c", "c.mctoken")},
+		},
+		{
+			name:    "shared token file",
+			bots:    []BotConfig{microsoft("a", "shared.mctoken"), microsoft("b", "./shared.mctoken")},
+			wantErr: `token_file "./shared.mctoken" is used by bot "a" too`,
+		},
+		{
+			name:    "default token file taken",
+			bots:    []BotConfig{microsoft("a", ""), microsoft("b", "a.mctoken")},
+			wantErr: `used by bot "a"`,
+		},
+		{
+			name: "pinned protocol",
+			bots: []BotConfig{{ID: "a", Address: "localhost", Auth: AuthOffline, Username: "A", Protocol: 766}},
+		},
+		{
+			name:    "unknown protocol",
+			bots:    []BotConfig{{ID: "a", Address: "localhost", Auth: AuthOffline, Username: "A", Protocol: 765}},
+			wantErr: "unsupported protocol 765, supported are 1.21 (protocol 767), 1.20.5 (protocol 766)",
+		},
+		{
+			name: "offline bots without token files",
+			bots: []BotConfig{
+				{ID: "a", Address: "localhost", Auth: AuthOffline, Username: "A"},
+				{ID: "b", Address: "localhost", Auth: AuthOffline, Username: "B"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("MS_TOKEN_KEY", "")
+			t.Setenv("MS_TOKEN_KEY_FILE", "")
+
+			cfg := Config{Bots: tt.bots}
+
+			err := cfg.validate()
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("validate: %v", err)
+			}
+
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("validate = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
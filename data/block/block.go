@@ -0,0 +1,72 @@
+// Package block classifies the block states of protocol 767 as far as the
+// movement simulation needs it: the fluids and the collision shape of each
+// state. The tables in states.go are generated from the server's blocks
+// report by cmd/gendata.
+package block
+
+const Air = 0
+
+// Box is a part of a collision shape, relative to the block's lowest
+// corner. Fences and walls reach 1.5 up.
+type Box struct {
+	MinX, MinY, MinZ float64
+	MaxX, MaxY, MaxZ float64
+}
+
+// Shape returns the collision shape of the state, none for air, fluids and
+// plants. States the tables don't know collide as a full cube.
+func Shape(state int32) []Box {
+	if state < 0 || int(state) >= len(stateShapes) {
+		return []Box{{MaxX: 1, MaxY: 1, MaxZ: 1}}
+	}
+
+	return shapes[stateShapes[state]]
+}
+
+// HasCollision reports whether entities collide with the state.
+func HasCollision(state int32) bool {
+	return len(Shape(state)) > 0
+}
+
+// IsWater reports whether the state holds water: water itself, waterlogged
+// blocks and the blocks that only exist under water, such as kelp.
+func IsWater(state int32) bool {
+	if state >= waterMin && state <= waterMax {
+		return true
+	}
+
+	return state >= 0 && int(state) < len(waterlogged)*64 && waterlogged[state/64]&(1<<(state%64)) != 0
+}
+
+func IsLava(state int32) bool {
+	return state >= lavaMin && state <= lavaMax
+}
+
+// FluidLevel returns the level of a water or lava state: 0 for a source,
+// 1-7 for flowing and 8 or more for falling fluid. Waterlogged blocks hold
+// a source.
+func FluidLevel(state int32) int {
+	switch {
+	case state >= waterMin && state <= waterMax:
+		return int(state - waterMin)
+	case IsLava(state):
+		return int(state - lavaMin)
+	}
+
+	return 0
+}
+
+// FluidHeight returns how much of the block the fluid fills, 0 if the
+// state isn't a fluid.
+func FluidHeight(state int32) float64 {
+	if !IsWater(state) && !IsLava(state) {
+		return 0
+	}
+
+	level := FluidLevel(state)
+	if level == 0 || level >= 8 {
+		return 8.0 / 9
+	}
+
+	return float64(8-level) / 9
+}
@@ -0,0 +1,57 @@
+package block
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestShape(t *testing.T) {
+	full := []Box{{MaxX: 1, MaxY: 1, MaxZ: 1}}
+
+	tests := []struct {
+		name  string
+		state int32
+		want  []Box
+		water bool
+	}{
+		{name: "air", state: Air},
+		{name: "cave air", state: 12959},
+		{name: "stone", state: 1, want: full},
+		{name: "short grass", state: 2005},
+		{name: "torch", state: 2355},
+		{name: "rail", state: 4663},
+		{name: "sign", state: 4303},
+		{name: "water", state: 80, water: true},
+		{name: "kelp", state: 12760, water: true},
+		{name: "bottom slab", state: 11165, want: []Box{{MaxX: 1, MaxY: 0.5, MaxZ: 1}}},
+		{name: "waterlogged top slab", state: 11162, want: []Box{{MinY: 0.5, MaxX: 1, MaxY: 1, MaxZ: 1}}, water: true},
+		{name: "one snow layer", state: 5772},
+		{name: "three snow layers", state: 5774, want: []Box{{MaxX: 1, MaxY: 0.25, MaxZ: 1}}},
+		{name: "carpet", state: 10728, want: []Box{{MaxX: 1, MaxY: 0.0625, MaxZ: 1}}},
+		{name: "fence post", state: 5848, want: []Box{{MinX: 0.375, MinZ: 0.375, MaxX: 0.625, MaxY: 1.5, MaxZ: 0.625}}},
+		{name: "open door", state: 4599, want: []Box{{MaxX: 0.1875, MaxY: 1, MaxZ: 1}}},
+		{
+			name:  "stairs",
+			state: 2945,
+			want:  []Box{{MaxX: 1, MaxY: 0.5, MaxZ: 1}, {MinX: 0.5, MinY: 0.5, MaxX: 1, MaxY: 1, MaxZ: 1}},
+		},
+		{name: "unknown", state: 1 << 20, want: full},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Shape(tt.state)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) && len(got)+len(tt.want) > 0 {
+				t.Errorf("Shape = %v, want %v", got, tt.want)
+			}
+
+			if HasCollision(tt.state) != (len(tt.want) > 0) {
+				t.Errorf("HasCollision = %v", HasCollision(tt.state))
+			}
+
+			if IsWater(tt.state) != tt.water {
+				t.Errorf("IsWater = %v, want %v", IsWater(tt.state), tt.water)
+			}
+		})
+	}
+}
@@ -0,0 +1,1435 @@
+// Code generated by gendata from the vanilla data reports; DO NOT EDIT.
+
+package block
+
+// The states of the fluid blocks, one for each level.
+const (
+	waterMin = 80
+	waterMax = 95
+	lavaMin  = 96
+	lavaMax  = 111
+)
+
+// shapes are the distinct collision shapes.
+var shapes = [...][]Box{
+	{},
+	{{0, 0, 0, 1, 1, 1}},
+	{{0, 0, 0, 1, 0.5625, 1}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0, 1, 0.5, 0.5}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0, 1, 0.5, 0.5}, {0, 0, 0.5, 0.5, 0.5, 1}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0, 1, 0.5, 0.5}, {0.5, 0, 0.5, 1, 0.5, 1}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0, 0.5, 0.5, 0.5}},
+	{{0, 0.5, 0, 1, 1, 1}, {0.5, 0, 0, 1, 0.5, 0.5}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0, 1, 1, 0.5}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0, 1, 1, 0.5}, {0, 0.5, 0.5, 0.5, 1, 1}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0, 1, 1, 0.5}, {0.5, 0.5, 0.5, 1, 1, 1}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0, 0.5, 1, 0.5}},
+	{{0, 0, 0, 1, 0.5, 1}, {0.5, 0.5, 0, 1, 1, 0.5}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0.5, 1, 0.5, 1}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0.5, 1, 0.5, 1}, {0.5, 0, 0, 1, 0.5, 0.5}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0.5, 1, 0.5, 1}, {0, 0, 0, 0.5, 0.5, 0.5}},
+	{{0, 0.5, 0, 1, 1, 1}, {0.5, 0, 0.5, 1, 0.5, 1}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0.5, 0.5, 0.5, 1}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0.5, 1, 1, 1}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0.5, 1, 1, 1}, {0.5, 0.5, 0, 1, 1, 0.5}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0.5, 1, 1, 1}, {0, 0.5, 0, 0.5, 1, 0.5}},
+	{{0, 0, 0, 1, 0.5, 1}, {0.5, 0.5, 0.5, 1, 1, 1}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0.5, 0.5, 1, 1}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0, 0.5, 0.5, 1}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0, 0.5, 0.5, 1}, {0.5, 0, 0.5, 1, 0.5, 1}},
+	{{0, 0.5, 0, 1, 1, 1}, {0, 0, 0, 0.5, 0.5, 1}, {0.5, 0, 0, 1, 0.5, 0.5}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0, 0.5, 1, 1}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0, 0.5, 1, 1}, {0.5, 0.5, 0.5, 1, 1, 1}},
+	{{0, 0, 0, 1, 0.5, 1}, {0, 0.5, 0, 0.5, 1, 1}, {0.5, 0.5, 0, 1, 1, 0.5}},
+	{{0, 0.5, 0, 1, 1, 1}, {0.5, 0, 0, 1, 0.5, 1}},
+	{{0, 0.5, 0, 1, 1, 1}, {0.5, 0, 0, 1, 0.5, 1}, {0, 0, 0, 0.5, 0.5, 0.5}},
+	{{0, 0.5, 0, 1, 1, 1}, {0.5, 0, 0, 1, 0.5, 1}, {0, 0, 0.5, 0.5, 0.5, 1}},
+	{{0, 0, 0, 1, 0.5, 1}, {0.5, 0.5, 0, 1, 1, 1}},
+	{{0, 0, 0, 1, 0.5, 1}, {0.5, 0.5, 0, 1, 1, 1}, {0, 0.5, 0, 0.5, 1, 0.5}},
+	{{0, 0, 0, 1, 0.5, 1}, {0.5, 0.5, 0, 1, 1, 1}, {0, 0.5, 0.5, 0.5, 1, 1}},
+	{{0.0625, 0, 0.0625, 0.9375, 0.875, 0.9375}},
+	{{0, 0, 0, 1, 0.9375, 1}},
+	{{0, 0, 0, 0.1875, 1, 1}},
+	{{0, 0, 0.8125, 1, 1, 1}},
+	{{0.8125, 0, 0, 1, 1, 1}},
+	{{0, 0, 0, 1, 1, 0.1875}},
+	{{0, 0, 0, 1, 0.125, 1}},
+	{{0, 0, 0, 1, 0.25, 1}},
+	{{0, 0, 0, 1, 0.375, 1}},
+	{{0, 0, 0, 1, 0.5, 1}},
+	{{0, 0, 0, 1, 0.625, 1}},
+	{{0, 0, 0, 1, 0.75, 1}},
+	{{0, 0, 0, 1, 0.875, 1}},
+	{{0.0625, 0, 0.0625, 0.9375, 0.9375, 0.9375}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 0.625, 1.5, 1}, {0, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 1, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 0.625, 1.5, 1}, {0.375, 0, 0.375, 1, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0, 0.625, 1.5, 0.625}, {0, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 1, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 1, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 0.625, 1.5, 1}, {0, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 1, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 0.625, 1.5, 1}, {0.375, 0, 0.375, 1, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 1, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 1, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 0.625, 1.5, 1}, {0, 0, 0.375, 0.625, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 0.625, 1.5, 1}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0, 0.625, 1.5, 0.625}, {0, 0, 0.375, 0.625, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0, 0.625, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 0.625, 1.5, 1}, {0, 0, 0.375, 0.625, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0.375, 0, 0.375, 0.625, 1.5, 1}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}, {0, 0, 0.375, 0.625, 1.5, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1.5, 0.625}},
+	{{0.0625, 0, 0.0625, 0.9375, 0.5, 0.9375}},
+	{{0.1875, 0, 0.0625, 0.9375, 0.5, 0.9375}},
+	{{0.3125, 0, 0.0625, 0.9375, 0.5, 0.9375}},
+	{{0.4375, 0, 0.0625, 0.9375, 0.5, 0.9375}},
+	{{0.5625, 0, 0.0625, 0.9375, 0.5, 0.9375}},
+	{{0.6875, 0, 0.0625, 0.9375, 0.5, 0.9375}},
+	{{0.8125, 0, 0.0625, 0.9375, 0.5, 0.9375}},
+	{{0, 0.8125, 0, 1, 1, 1}},
+	{{0, 0, 0, 1, 0.1875, 1}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 0.5625, 1, 1}, {0, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 1, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 0.5625, 1, 1}, {0.4375, 0, 0.4375, 1, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0, 0.5625, 1, 0.5625}, {0, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 1, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 1, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 0.5625, 1, 1}, {0, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 1, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 0.5625, 1, 1}, {0.4375, 0, 0.4375, 1, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 1, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 1, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 0.5625, 1, 1}, {0, 0, 0.4375, 0.5625, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 0.5625, 1, 1}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0, 0.5625, 1, 0.5625}, {0, 0, 0.4375, 0.5625, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0, 0.5625, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 0.5625, 1, 1}, {0, 0, 0.4375, 0.5625, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0.4375, 0, 0.4375, 0.5625, 1, 1}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}, {0, 0, 0.4375, 0.5625, 1, 0.5625}},
+	{{0.4375, 0, 0.4375, 0.5625, 1, 0.5625}},
+	{{0, 0.40625, 0.40625, 1, 0.59375, 0.59375}},
+	{{0.40625, 0, 0.40625, 0.59375, 1, 0.59375}},
+	{{0.40625, 0.40625, 0, 0.59375, 0.59375, 1}},
+	{{0, 0, 0.375, 1, 1.5, 0.625}},
+	{{0.375, 0, 0, 0.625, 1.5, 1}},
+	{{0.0625, 0, 0.0625, 0.9375, 0.09375, 0.9375}},
+	{{0.0625, 0, 0.0625, 0.9375, 0.125, 0.9375}, {0.4375, 0, 0.4375, 0.5625, 0.875, 0.5625}},
+	{{0, 0, 0, 1, 0.8125, 1}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}},
+	{{0, 0, 0.3125, 0.6875, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}},
+	{{0.3125, 0, 0.3125, 0.6875, 1.5, 1}},
+	{{0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0, 0.6875, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}},
+	{{0.3125, 0, 0, 0.6875, 1.5, 0.6875}},
+	{{0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}},
+	{{0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}},
+	{{0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0, 0, 0.3125, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 1.5, 0.75}, {0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.3125, 0, 0, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 0.6875, 1.5, 1}, {0, 0, 0.3125, 0.6875, 1.5, 0.6875}, {0.3125, 0, 0.3125, 1, 1.5, 0.6875}},
+	{{0.3125, 0, 0.3125, 0.6875, 0.375, 0.6875}},
+	{{0.25, 0, 0.25, 0.75, 0.5, 0.75}},
+	{{0.25, 0.25, 0.5, 0.75, 0.75, 1}},
+	{{0.25, 0.25, 0, 0.75, 0.75, 0.5}},
+	{{0.5, 0.25, 0.25, 1, 0.75, 0.75}},
+	{{0, 0.25, 0.25, 0.5, 0.75, 0.75}},
+	{{0, 0.5, 0, 1, 1, 1}},
+	{{0, 0, 0, 1, 0.0625, 1}},
+	{{0.375, 0.375, 0, 0.625, 0.625, 1}},
+	{{0, 0.375, 0.375, 1, 0.625, 0.625}},
+	{{0.375, 0, 0.375, 0.625, 1, 0.625}},
+	{{0.1875, 0, 0.1875, 0.75, 0.4375, 0.75}},
+	{{0.0625, 0, 0.0625, 0.9375, 0.4375, 0.9375}},
+	{{0.375, 0, 0.375, 0.625, 0.375, 0.625}},
+	{{0.1875, 0, 0.1875, 0.8125, 0.375, 0.8125}},
+	{{0.125, 0, 0.125, 0.875, 0.375, 0.875}},
+	{{0.125, 0, 0.125, 0.875, 0.4375, 0.875}},
+	{{0.3125, 0.3125, 0.3125, 0.6875, 0.6875, 0.6875}},
+	{{0, 0.875, 0, 1, 1, 1}},
+	{{0.3125, 0.0625, 0.3125, 0.6875, 0.5, 0.6875}, {0.375, 0.5, 0.375, 0.625, 0.625, 0.625}},
+	{{0.3125, 0, 0.3125, 0.6875, 0.4375, 0.6875}, {0.375, 0.4375, 0.375, 0.625, 0.5625, 0.625}},
+	{{0, 0, 0, 1, 0.4375, 1}},
+	{{0.4375, 0, 0.4375, 0.5625, 0.375, 0.5625}},
+	{{0.3125, 0, 0.375, 0.6875, 0.375, 0.5625}},
+	{{0.3125, 0, 0.375, 0.625, 0.375, 0.6875}},
+	{{0.3125, 0, 0.3125, 0.6875, 0.375, 0.625}},
+	{{0.0625, 0, 0.0625, 0.9375, 0.5, 0.9375}, {0.4375, 0.5, 0.4375, 0.5625, 0.875, 0.5625}},
+	{{0, 0.5, 0, 1, 1, 1}, {0.375, 0, 0.375, 0.625, 0.5, 0.625}},
+	{{0, 0.6875, 0, 1, 0.9375, 1}},
+	{{0, 0.6875, 0, 1, 0.8125, 1}},
+	{{0.0625, 0, 0.0625, 0.9375, 1, 0.9375}},
+}
+
+// stateShapes are the indices into shapes of the states.
+var stateShapes = [...]uint8{
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 3, 3, 4, 4, 5, 5,
+	6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21,
+	22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16,
+	32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+	35, 35, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 36, 36,
+	36, 36, 36, 36, 36, 36, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39,
+	40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40,
+	37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 38, 38, 40, 40, 39, 39, 37, 37, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13,
+	14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27,
+	28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38,
+	39, 39, 38, 38, 39, 39, 40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39,
+	40, 40, 39, 39, 40, 40, 37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 41, 42, 43, 44, 45, 46, 47, 1, 1, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48,
+	48, 48, 48, 48, 48, 48, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 49, 50, 49, 50, 51, 52, 51,
+	52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58, 57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 1, 47, 1, 1, 1, 1, 1,
+	1, 1, 0, 0, 0, 0, 0, 1, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 65, 66, 67, 68, 69, 70, 71, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72,
+	72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72,
+	72, 37, 37, 37, 37, 73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72,
+	72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72,
+	72, 37, 37, 37, 37, 73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72,
+	72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72,
+	72, 37, 37, 37, 37, 73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72,
+	72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72,
+	72, 37, 37, 37, 37, 73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72,
+	72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72,
+	72, 37, 37, 37, 37, 73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72,
+	72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72,
+	72, 37, 37, 37, 37, 73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72,
+	72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72,
+	72, 37, 37, 37, 37, 73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72,
+	72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72,
+	72, 37, 37, 37, 37, 73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72,
+	72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72,
+	72, 37, 37, 37, 37, 73, 73, 73, 73, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 74, 75, 74, 75, 76, 77, 76, 77, 78, 79, 78,
+	79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 90, 90, 91, 91, 92, 92, 74, 75, 74, 75, 76,
+	77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 1, 1, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93,
+	93, 0, 0, 93, 93, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8,
+	8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24,
+	24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34,
+	34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16,
+	16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11,
+	11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8,
+	8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24,
+	24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34,
+	34, 12, 12, 21, 21, 1, 1, 95, 1, 49, 50, 49, 50, 51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58, 57, 58, 59, 60, 59,
+	60, 61, 62, 61, 62, 63, 64, 63, 64, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14,
+	14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28,
+	28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 0, 0, 0, 0, 46, 96, 96,
+	96, 96, 96, 96, 96, 96, 1, 1, 1, 1, 1, 1, 1, 1, 0, 97, 97, 97, 97, 97, 97, 97, 97, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15,
+	15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22,
+	22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 1, 1, 35, 35, 35, 35, 35, 35, 35,
+	35, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102,
+	102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109,
+	110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108,
+	107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114,
+	114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121,
+	122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128,
+	127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126,
+	126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117,
+	118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128,
+	127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126,
+	126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101,
+	102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108,
+	107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106,
+	106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113,
+	114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120,
+	119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126,
+	126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125,
+	126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120,
+	119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126,
+	126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125,
+	126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 129, 129, 0, 0, 0, 0, 0, 0, 0,
+	129, 129, 129, 129, 129, 129, 129, 0, 0, 0, 0, 129, 129, 129, 129, 129, 129, 129, 129, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 131, 131, 132, 132, 133,
+	133, 134, 134, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 131, 131, 132, 132, 133, 133, 134, 134, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 131, 131, 132, 132, 133, 133, 134, 134, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 131, 131, 132, 132, 133, 133, 134, 134, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 131, 131, 132, 132, 133,
+	133, 134, 134, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 131, 131, 132, 132, 133, 133, 134, 134, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 131, 131, 132, 132, 133, 133, 134, 134, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 35,
+	35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
+	43, 43, 43, 43, 43, 43, 43, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 3, 4, 4, 5, 5, 6, 6,
+	7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22,
+	23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32,
+	33, 33, 34, 34, 12, 12, 21, 21, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 74, 75, 74, 75,
+	76, 77, 76, 77, 78, 79, 78, 79, 80, 81, 80, 81, 82, 83, 82, 83, 84, 85, 84, 85, 86, 87, 86, 87, 88, 89, 88, 89, 3, 3, 4, 4,
+	5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20,
+	21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7,
+	16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12,
+	13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26,
+	27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4,
+	5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20,
+	21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7,
+	16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12,
+	13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26,
+	27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4,
+	5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20,
+	21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7,
+	16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12,
+	13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26,
+	27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 1, 1, 1, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 38,
+	38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40, 73, 73, 73, 73, 39,
+	39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37, 73, 73, 73, 73, 1,
+	1, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135,
+	44, 44, 1, 1, 1, 1, 1, 1, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 1, 1, 1, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13,
+	14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27,
+	28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 135, 135, 44, 44, 1, 1,
+	135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135,
+	44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44,
+	1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1,
+	135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135,
+	44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 1, 1, 1, 1, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0,
+	94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0,
+	94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0,
+	94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0,
+	94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0,
+	94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0,
+	94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0,
+	94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0,
+	94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 49, 50, 49, 50, 51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58,
+	57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 49, 50, 49, 50, 51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58,
+	57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 49, 50, 49, 50, 51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58,
+	57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 49, 50, 49, 50, 51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58,
+	57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 49, 50, 49, 50, 51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58,
+	57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 49, 50, 49, 50, 51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58,
+	57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 49, 50, 49, 50, 51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58,
+	57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 49, 50, 49, 50, 51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58,
+	57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39,
+	40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40,
+	37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39,
+	40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40,
+	37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39,
+	40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40,
+	37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39,
+	40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40,
+	37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39,
+	40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40,
+	37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39,
+	40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40,
+	37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39,
+	40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40,
+	37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39,
+	40, 40, 37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40,
+	37, 37, 38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 137, 138, 137, 138, 139, 139, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 3,
+	4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19,
+	20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31,
+	7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 36, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 140, 140, 140, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 142, 142, 143, 143, 144, 144, 145, 145, 1, 146, 146, 0, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 129, 0, 0,
+	0, 0, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9,
+	10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25,
+	17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12,
+	21, 21, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1,
+	135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135,
+	44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101,
+	102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108,
+	109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108,
+	108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113,
+	114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120,
+	121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120,
+	117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100,
+	101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108,
+	108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105,
+	106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112,
+	113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120,
+	120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120,
+	120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98, 99, 99, 0, 100,
+	100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105,
+	106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112,
+	105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112,
+	112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117,
+	118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117,
+	118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124,
+	124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98,
+	99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104,
+	105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112,
+	112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109,
+	110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120,
+	117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116,
+	117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124,
+	124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121,
+	122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104,
+	104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109,
+	110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112,
+	109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120,
+	120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116,
+	116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121,
+	122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101,
+	102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112,
+	109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112,
+	112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117,
+	118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124,
+	124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113,
+	114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120,
+	121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104,
+	101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112,
+	112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109,
+	110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116,
+	117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124,
+	124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121,
+	122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120,
+	120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104,
+	104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109,
+	110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108,
+	109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116,
+	116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121,
+	122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117,
+	118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101,
+	102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108,
+	109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108,
+	108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113,
+	114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120,
+	121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120,
+	117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100,
+	101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108,
+	108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105,
+	106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112,
+	113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120,
+	120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120,
+	120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98, 99, 99, 0, 100,
+	100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105,
+	106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112,
+	105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112,
+	112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117,
+	118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117,
+	118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124,
+	124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 98, 99, 99, 98,
+	99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104,
+	105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112,
+	112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109,
+	110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120,
+	117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116,
+	117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124,
+	124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121,
+	122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104,
+	104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109,
+	110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112,
+	109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120,
+	120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116,
+	116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121,
+	122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 148, 148, 149, 149, 148, 148, 149, 149, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 0, 0, 0, 0, 49, 50, 49, 50,
+	51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58, 57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 49, 50, 49, 50,
+	51, 52, 51, 52, 53, 54, 53, 54, 55, 56, 55, 56, 57, 58, 57, 58, 59, 60, 59, 60, 61, 62, 61, 62, 63, 64, 63, 64, 38, 38, 38, 38,
+	72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39,
+	72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37, 73, 73, 73, 73, 38, 38, 38, 38,
+	72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40, 73, 73, 73, 73, 39, 39, 39, 39,
+	72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37, 73, 73, 73, 73, 0, 0, 93, 93,
+	0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 93, 93,
+	0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 93, 93, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 0, 0, 94, 94, 3, 3, 4, 4,
+	5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20,
+	21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7,
+	16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12,
+	13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26,
+	27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40,
+	37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37,
+	38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40,
+	37, 37, 40, 40, 39, 39, 40, 40, 37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37,
+	38, 38, 37, 37, 40, 40, 37, 37, 38, 38, 37, 37, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 48, 1, 1, 1, 1, 1, 1, 1, 1, 1, 129,
+	129, 129, 129, 1, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16,
+	16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11,
+	11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100,
+	100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107,
+	108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106,
+	105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112,
+	112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119,
+	120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126,
+	125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128,
+	128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119,
+	120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126,
+	125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124,
+	124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 135, 135, 44, 44, 1, 1, 1,
+	1, 1, 1, 135, 135, 44, 44, 1, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14,
+	14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28,
+	28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 98, 99, 99, 98, 99, 99, 0,
+	100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106,
+	105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112,
+	112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111,
+	112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118,
+	117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128,
+	128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127,
+	128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118,
+	117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124,
+	124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123,
+	124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 1, 3, 3,
+	4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19,
+	20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31,
+	7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 135, 135, 44, 44, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102,
+	103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110,
+	110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107,
+	108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114,
+	115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122,
+	122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127,
+	128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126,
+	127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118,
+	118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127,
+	128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126,
+	127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 1, 1, 1, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153,
+	153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153, 153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153,
+	153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153, 153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153,
+	153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153, 153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153,
+	153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153, 153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153,
+	153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153, 153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153,
+	153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153, 153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153,
+	153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153, 153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153,
+	153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153, 153, 154, 154, 154, 154, 151, 151, 151, 151, 152, 152, 152, 152, 153, 153, 153,
+	153, 154, 154, 154, 154, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 135, 135, 44, 44, 1, 1,
+	3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18,
+	19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30,
+	31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101,
+	102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108,
+	109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108,
+	108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113,
+	114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120,
+	121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120,
+	117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125,
+	126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 1, 135, 135, 44, 44, 1, 1, 3, 3, 4, 4, 5,
+	5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21,
+	21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16,
+	16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104,
+	103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110,
+	110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109,
+	110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116,
+	115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122,
+	122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121,
+	122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128,
+	127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118,
+	118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125,
+	126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128,
+	127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 1, 1, 135, 135, 44, 44, 1, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7,
+	7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23,
+	23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33,
+	33, 34, 34, 12, 12, 21, 21, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101,
+	102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112,
+	111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110,
+	110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117,
+	118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124,
+	123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122,
+	122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113,
+	114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120,
+	119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126,
+	126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125,
+	126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 1, 1, 1, 0, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 44, 44, 44, 44, 44, 44, 44, 44, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12,
+	13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26,
+	27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4,
+	5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20,
+	21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7,
+	16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12,
+	13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26,
+	27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4,
+	5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20,
+	21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7,
+	16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135,
+	44, 44, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12,
+	13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26,
+	27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4,
+	5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20,
+	21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7,
+	16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12,
+	13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26,
+	27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 3, 3, 4, 4,
+	5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20,
+	21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7,
+	16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135, 44, 44, 1, 1, 135, 135,
+	44, 44, 1, 1, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40, 37, 37, 40, 40, 39, 39, 40, 40,
+	37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37, 38, 38, 37, 37, 40, 40, 37, 37,
+	38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40, 37, 37, 40, 40, 39, 39, 40, 40,
+	37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37, 38, 38, 37, 37, 40, 40, 37, 37,
+	38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40, 37, 37, 40, 40, 39, 39, 40, 40,
+	37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37, 38, 38, 37, 37, 40, 40, 37, 37,
+	38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40, 37, 37, 40, 40, 39, 39, 40, 40,
+	37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37, 38, 38, 37, 37, 40, 40, 37, 37,
+	38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40, 37, 37, 40, 40, 39, 39, 40, 40,
+	37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37, 38, 38, 37, 37, 40, 40, 37, 37,
+	38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40, 37, 37, 40, 40, 39, 39, 40, 40,
+	37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37, 38, 38, 37, 37, 40, 40, 37, 37,
+	38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40, 37, 37, 40, 40, 39, 39, 40, 40,
+	37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37, 38, 38, 37, 37, 40, 40, 37, 37,
+	38, 38, 37, 37, 37, 37, 38, 38, 39, 39, 38, 38, 37, 37, 38, 38, 39, 39, 38, 38, 39, 39, 40, 40, 37, 37, 40, 40, 39, 39, 40, 40,
+	37, 37, 40, 40, 38, 38, 39, 39, 40, 40, 39, 39, 38, 38, 39, 39, 40, 40, 39, 39, 40, 40, 37, 37, 38, 38, 37, 37, 40, 40, 37, 37,
+	38, 38, 37, 37, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40,
+	73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37,
+	73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40,
+	73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37,
+	73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40,
+	73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37,
+	73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40,
+	73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37,
+	73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40,
+	73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37,
+	73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40,
+	73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37,
+	73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40,
+	73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37,
+	73, 73, 73, 73, 38, 38, 38, 38, 72, 72, 72, 72, 38, 38, 38, 38, 73, 73, 73, 73, 40, 40, 40, 40, 72, 72, 72, 72, 40, 40, 40, 40,
+	73, 73, 73, 73, 39, 39, 39, 39, 72, 72, 72, 72, 39, 39, 39, 39, 73, 73, 73, 73, 37, 37, 37, 37, 72, 72, 72, 72, 37, 37, 37, 37,
+	73, 73, 73, 73, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 137, 137, 137, 137, 138, 138, 138, 138, 137, 137, 137, 137,
+	138, 138, 138, 138, 139, 139, 139, 139, 139, 139, 139, 139, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 156, 156, 136, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 157, 157, 157, 157, 158, 158, 0, 0, 157, 157, 157, 157, 158, 158, 0, 0, 157, 157, 157, 157,
+	158, 158, 0, 0, 157, 157, 157, 157, 158, 158, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1, 47, 1, 1, 1, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12,
+	13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26,
+	27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 135, 135, 44, 44,
+	1, 1, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102,
+	103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110,
+	110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111,
+	112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118,
+	119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126,
+	126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123,
+	124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114,
+	115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122,
+	122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127,
+	128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126,
+	127, 128, 128, 127, 128, 128, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15,
+	15, 16, 16, 17, 17, 18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22,
+	22, 11, 11, 29, 29, 30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 135, 135, 44, 44, 1, 1, 98, 99, 99,
+	98, 99, 99, 0, 100, 100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104,
+	104, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111,
+	112, 112, 111, 112, 112, 105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110,
+	109, 110, 110, 111, 112, 112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120,
+	120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127,
+	128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126,
+	125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116,
+	116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123,
+	124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122,
+	121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128,
+	128, 1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17,
+	18, 18, 19, 19, 20, 20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29,
+	30, 30, 31, 31, 7, 7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 135, 135, 44, 44, 1, 1, 98, 99, 99, 98, 99, 99, 0, 100,
+	100, 0, 100, 100, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105,
+	106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112,
+	105, 106, 106, 105, 106, 106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112,
+	112, 111, 112, 112, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117,
+	118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128,
+	128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117,
+	118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124,
+	125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124,
+	124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 1, 3, 3, 4,
+	4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16, 17, 17, 18, 18, 19, 19, 20,
+	20, 21, 21, 22, 22, 23, 23, 24, 24, 25, 25, 17, 17, 6, 6, 26, 26, 27, 27, 28, 28, 22, 22, 11, 11, 29, 29, 30, 30, 31, 31, 7,
+	7, 16, 16, 32, 32, 33, 33, 34, 34, 12, 12, 21, 21, 135, 135, 44, 44, 1, 1, 98, 99, 99, 98, 99, 99, 0, 100, 100, 0, 100, 100, 101,
+	102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 101, 102, 102, 101, 102, 102, 103, 104, 104, 103, 104, 104, 105, 106, 106, 105, 106, 106, 107, 108, 108,
+	107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 105, 106, 106, 105, 106,
+	106, 107, 108, 108, 107, 108, 108, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 109, 110, 110, 109, 110, 110, 111, 112, 112, 111, 112, 112, 113,
+	114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120,
+	119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126,
+	126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125,
+	126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 113, 114, 114, 113, 114, 114, 115, 116, 116, 115, 116, 116, 117, 118, 118, 117, 118, 118, 119, 120, 120,
+	119, 120, 120, 117, 118, 118, 117, 118, 118, 119, 120, 120, 119, 120, 120, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125, 126, 126, 125, 126,
+	126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 121, 122, 122, 121, 122, 122, 123, 124, 124, 123, 124, 124, 125,
+	126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 125, 126, 126, 125, 126, 126, 127, 128, 128, 127, 128, 128, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 129, 129, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 1, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 130, 130,
+}
+
+// waterlogged is a bit set of the states that hold water besides their
+// block.
+var waterlogged = [...]uint64{
+	0xaaaaaa8000000000,
+	0x0000000000002aaa,
+	0x0000000004000000,
+	0xaaaaa00000000000,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0x000000000000000a,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x5555555555000000,
+	0x0000000007000055,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x5400000000000000,
+	0x5555555555555555,
+	0x0000000155555555,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x5555555555554000,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x0000155555555555,
+	0x5555400000000000,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x0155555555555555,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x6600000000000000,
+	0x0000000000666666,
+	0x0000000000000000,
+	0xaaaaaaaaaaaaaa00,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0x00000000000000aa,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x9aa6666666600000,
+	0x0000000001999999,
+	0x6666666666600000,
+	0x6666666666666666,
+	0xaaa0000000066666,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0x6666660aaaaaaaaa,
+	0xaaaaaaaaaaaaaa66,
+	0x0000000000aaaaaa,
+	0xaaaaaaaaaaaaaa80,
+	0x00000000aa2aaaaa,
+	0x0000000000000000,
+	0x5554000000000000,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x38e3800155555555,
+	0xe38e38e38e38e38e,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0xe38e38e38e38e38e,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0xe38e38e38e38e38e,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0x000e38e38e38e38e,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x002aaaaa80000000,
+	0x0000000000000000,
+	0x5555555555000000,
+	0x5555555555555555,
+	0x3333333330000000,
+	0x3333333333333333,
+	0x3333333333333333,
+	0x3333333333333333,
+	0x3333333333333333,
+	0x3333333333333333,
+	0x3333333333333333,
+	0x3333333333333333,
+	0x5555555553333333,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0xa555555555555555,
+	0xaaaaaaaaaaaaaaaa,
+	0x555555542aaaaaaa,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x0000000555555555,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x5555555555555400,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x0000015555555555,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0xccccc00000000000,
+	0xcccccccccccccccc,
+	0xcccccccccccccccc,
+	0xcccccccccccccccc,
+	0x00000ccccccccccc,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x4000000000000000,
+	0x5555555555555555,
+	0x0000000000001555,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0007ffffff000000,
+	0xaaaaaaaaaaaaa000,
+	0xaaaaaaaaaaaaaaaa,
+	0x5555555700004aaa,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x1c71c71c71c75555,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x0000000555555551,
+	0x0000000000000000,
+	0xaaaaaaaaaaaaaa80,
+	0x0000000000002aaa,
+	0x3055500000000000,
+	0x5333333333333333,
+	0x5555555555555555,
+	0x0555555555555555,
+	0x5000000000000000,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x0000000005555555,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x5555555555555000,
+	0x0000000005555555,
+	0x0000000000000000,
+	0xaaaaaaaaaaaaaaa0,
+	0x8e38e38e38eaaaaa,
+	0x38e38e38e38e38e3,
+	0xe38e38e38e38e38e,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0xaaaaaaa82a38e38e,
+	0x8eaaaaaaaaaaaaaa,
+	0x38e38e38e38e38e3,
+	0xe38e38e38e38e38e,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0x438e38e38e38e38e,
+	0x5555555555555555,
+	0x1c71c00000055555,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xaaa071c71c71c71c,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaa000000000a,
+	0x5555555554aaaaaa,
+	0x71c7555555555555,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0xaaa1c71c71c71c71,
+	0xaaaaaaaaaaaaaaaa,
+	0x38e38e38e38e3aaa,
+	0xe38e38e38e38e38e,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0xe38e38e38e38e38e,
+	0xaaaaaaaaaaaa0e38,
+	0x8e38e3aaaaaaaaaa,
+	0x38e38e38e38e38e3,
+	0xe38e38e38e38e38e,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0xaaaa80e38e38e38e,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0xaaaaaaaaaaaaaaaa,
+	0x3333333333332aaa,
+	0x3333333333333333,
+	0x5555500001543333,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555005,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x0000000555555555,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x0000000000000000,
+	0x5555555000000000,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x5555555555555555,
+	0x0005555555555555,
+	0x5555555555500000,
+	0x0000000000000000,
+	0x5555555555555000,
+	0x5555555555555015,
+	0x1c71c71d55555555,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xaaaaaa871c71c71c,
+	0xeaaaaaaaaaaaaaaa,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0xe38e38e38e38e38e,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0x5555555555555554,
+	0x71c71c71c7555555,
+	0xc71c71c71c71c71c,
+	0x1c71c71c71c71c71,
+	0x71c71c71c71c71c7,
+	0xc71c71c71c71c71c,
+	0xaaaaaaaaa1c71c71,
+	0x8e3aaaaaaaaaaaaa,
+	0x38e38e38e38e38e3,
+	0xe38e38e38e38e38e,
+	0x8e38e38e38e38e38,
+	0x38e38e38e38e38e3,
+	0x000e38e38e38e38e,
+	0x0000000015554000,
+	0x0400000000000000,
+}
@@ -0,0 +1,71 @@
+// Package entity names the entity types of the game, by their ID in the
+// minecraft:entity_type registry of protocol 767, see registryid.
+package entity
+
+import (
+	"strings"
+
+	"mcAfkGo/data/registryid"
+)
+
+// TypeName returns the name of the entity type id, without namespace, or
+// "" if unknown.
+func TypeName(id int32) string {
+	if id < 0 || int(id) >= len(registryid.EntityType) {
+		return ""
+	}
+
+	return strings.TrimPrefix(registryid.EntityType[id], "minecraft:")
+}
+
+// TypeID returns the ID of the entity type named without namespace, or -1.
+func TypeID(name string) int32 {
+	return registryid.ID(registryid.EntityType, "minecraft:"+name)
+}
+
+// hostile are the mobs that attack players on sight.
+var hostile = map[string]bool{
+	"blaze":           true,
+	"bogged":          true,
+	"breeze":          true,
+	"cave_spider":     true,
+	"creeper":         true,
+	"drowned":         true,
+	"elder_guardian":  true,
+	"ender_dragon":    true,
+	"endermite":       true,
+	"evoker":          true,
+	"ghast":           true,
+	"giant":           true,
+	"guardian":        true,
+	"hoglin":          true,
+	"husk":            true,
+	"illusioner":      true,
+	"magma_cube":      true,
+	"phantom":         true,
+	"piglin":          true,
+	"piglin_brute":    true,
+	"pillager":        true,
+	"ravager":         true,
+	"shulker":         true,
+	"silverfish":      true,
+	"skeleton":        true,
+	"slime":           true,
+	"spider":          true,
+	"stray":           true,
+	"vex":             true,
+	"vindicator":      true,
+	"warden":          true,
+	"witch":           true,
+	"wither":          true,
+	"wither_skeleton": true,
+	"zoglin":          true,
+	"zombie":          true,
+	"zombie_villager": true,
+}
+
+// IsHostile reports whether the entity type attacks players on sight.
+// Neutral mobs like endermen and zombified piglins are not.
+func IsHostile(id int32) bool {
+	return hostile[TypeName(id)]
+}
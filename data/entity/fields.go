@@ -0,0 +1,251 @@
+package entity
+
+import (
+	"slices"
+
+	"mcAfkGo/data/registryid"
+)
+
+// Entity data fields shared by all entities.
+const (
+	FieldSharedFlags = 0
+	FieldPose        = 6
+)
+
+// Bits of the shared flags field.
+const (
+	FlagOnFire     = 0x01
+	FlagCrouching  = 0x02
+	FlagSprinting  = 0x08
+	FlagSwimming   = 0x10
+	FlagInvisible  = 0x20
+	FlagGlowing    = 0x40
+	FlagFallFlying = 0x80
+)
+
+// Poses of the pose field.
+const (
+	PoseStanding = iota
+	PoseFallFlying
+	PoseSleeping
+	PoseSwimming
+	PoseSpinAttack
+	PoseCrouching
+	PoseLongJumping
+	PoseDying
+	PoseCroaking
+	PoseUsingTongue
+	PoseSitting
+	PoseRoaring
+	PoseSniffing
+	PoseEmerging
+	PoseDigging
+	PoseSliding
+	PoseShooting
+	PoseInhaling
+)
+
+// class declares the entity data a class of entities adds to its parent's,
+// in index order.
+type class struct {
+	parent string
+	fields []string
+}
+
+var classes = map[string]class{
+	"entity": {"", []string{"shared_flags", "air_supply", "custom_name", "custom_name_visible", "silent", "no_gravity", "pose", "ticks_frozen"}},
+
+	"interaction":   {"entity", []string{"width", "height", "response"}},
+	"display":       {"entity", []string{"transformation_interpolation_start_delta_ticks", "transformation_interpolation_duration", "pos_rot_interpolation_duration", "translation", "scale", "left_rotation", "right_rotation", "billboard_render_constraints", "brightness_override", "view_range", "shadow_radius", "shadow_strength", "width", "height", "glow_color_override"}},
+	"block_display": {"display", []string{"block_state"}},
+	"item_display":  {"display", []string{"item_stack", "item_display"}},
+	"text_display":  {"display", []string{"text", "line_width", "background_color", "text_opacity", "style_flags"}},
+
+	"thrown_item":          {"entity", []string{"item_stack"}},
+	"eye_of_ender":         {"entity", []string{"item_stack"}},
+	"fireball":             {"entity", []string{"item_stack"}},
+	"wither_skull":         {"entity", []string{"dangerous"}},
+	"firework_rocket":      {"entity", []string{"item_stack", "attached_to_target", "shot_at_angle"}},
+	"item_frame":           {"entity", []string{"item", "rotation"}},
+	"painting":             {"entity", []string{"painting_variant"}},
+	"item":                 {"entity", []string{"item"}},
+	"ominous_item_spawner": {"entity", []string{"item"}},
+	"abstract_arrow":       {"entity", []string{"flags", "pierce_level"}},
+	"arrow":                {"abstract_arrow", []string{"effect_color"}},
+	"trident":              {"abstract_arrow", []string{"loyalty", "foil"}},
+	"fishing_bobber":       {"entity", []string{"hooked_entity", "biting"}},
+	"end_crystal":          {"entity", []string{"beam_target", "show_bottom"}},
+	"falling_block":        {"entity", []string{"start_pos"}},
+	"tnt":                  {"entity", []string{"fuse", "block_state"}},
+	"area_effect_cloud":    {"entity", []string{"radius", "waiting", "particle"}},
+	"boat":                 {"entity", []string{"hurt", "hurt_dir", "damage", "type", "paddle_left", "paddle_right", "bubble_time"}},
+
+	"abstract_minecart":      {"entity", []string{"hurt", "hurt_dir", "damage", "display_block", "display_offset", "custom_display"}},
+	"furnace_minecart":       {"abstract_minecart", []string{"fuel"}},
+	"command_block_minecart": {"abstract_minecart", []string{"command_name", "last_output"}},
+
+	"living":      {"entity", []string{"living_entity_flags", "health", "effect_particles", "effect_ambience", "arrow_count", "stinger_count", "sleeping_pos"}},
+	"player":      {"living", []string{"player_absorption", "score", "player_mode_customisation", "player_main_hand", "shoulder_left", "shoulder_right"}},
+	"armor_stand": {"living", []string{"client_flags", "head_pose", "body_pose", "left_arm_pose", "right_arm_pose", "left_leg_pose", "right_leg_pose"}},
+	"mob":         {"living", []string{"mob_flags"}},
+
+	"ageable":        {"mob", []string{"baby"}},
+	"tamable":        {"ageable", []string{"flags", "owner_uuid"}},
+	"wolf":           {"tamable", []string{"interested", "collar_color", "remaining_anger_time", "variant"}},
+	"cat":            {"tamable", []string{"variant", "is_lying", "relax_state_one", "collar_color"}},
+	"parrot":         {"tamable", []string{"variant"}},
+	"abstract_horse": {"ageable", []string{"flags"}},
+	"horse":          {"abstract_horse", []string{"type_variant"}},
+	"chested_horse":  {"abstract_horse", []string{"chest"}},
+	"llama":          {"chested_horse", []string{"strength", "swag", "variant"}},
+	"camel":          {"abstract_horse", []string{"dash", "last_pose_change_tick"}},
+	"pig":            {"ageable", []string{"saddle", "boost_time"}},
+	"strider":        {"ageable", []string{"boost_time", "suffocating", "saddle"}},
+	"sheep":          {"ageable", []string{"wool"}},
+	"rabbit":         {"ageable", []string{"type"}},
+	"turtle":         {"ageable", []string{"home_pos", "has_egg", "laying_egg", "travel_pos", "going_home", "travelling"}},
+	"polar_bear":     {"ageable", []string{"standing"}},
+	"fox":            {"ageable", []string{"type", "flags", "trusted_0", "trusted_1"}},
+	"ocelot":         {"ageable", []string{"trusting"}},
+	"panda":          {"ageable", []string{"unhappy_counter", "sneeze_counter", "eat_counter", "main_gene", "hidden_gene", "flags"}},
+	"bee":            {"ageable", []string{"flags", "remaining_anger_time"}},
+	"goat":           {"ageable", []string{"is_screaming_goat", "has_left_horn", "has_right_horn"}},
+	"frog":           {"ageable", []string{"variant", "tongue_target"}},
+	"axolotl":        {"ageable", []string{"variant", "playing_dead", "from_bucket"}},
+	"hoglin":         {"ageable", []string{"immune_to_zombification"}},
+	"mooshroom":      {"ageable", []string{"type"}},
+	"sniffer":        {"ageable", []string{"state", "drop_seed_at_tick"}},
+	"armadillo":      {"ageable", []string{"state"}},
+	"villager_base":  {"ageable", []string{"unhappy_counter"}},
+	"villager":       {"villager_base", []string{"villager_data"}},
+
+	"allay":           {"mob", []string{"dancing", "can_duplicate"}},
+	"dolphin":         {"mob", []string{"treasure_pos", "got_fish", "moistness_level"}},
+	"abstract_fish":   {"mob", []string{"from_bucket"}},
+	"pufferfish":      {"abstract_fish", []string{"puff_state"}},
+	"tropical_fish":   {"abstract_fish", []string{"type_variant"}},
+	"glow_squid":      {"mob", []string{"dark_ticks_remaining"}},
+	"iron_golem":      {"mob", []string{"flags"}},
+	"snow_golem":      {"mob", []string{"pumpkin"}},
+	"shulker":         {"mob", []string{"attach_face", "peek", "color"}},
+	"creeper":         {"mob", []string{"swell_dir", "is_powered", "is_ignited"}},
+	"zombie":          {"mob", []string{"baby", "special_type", "drowned_conversion"}},
+	"zombie_villager": {"zombie", []string{"converting", "villager_data"}},
+	"skeleton":        {"mob", []string{"stray_conversion"}},
+	"bogged":          {"mob", []string{"sheared"}},
+	"piglin_base":     {"mob", []string{"immune_to_zombification"}},
+	"piglin":          {"piglin_base", []string{"baby", "is_charging_crossbow", "is_dancing"}},
+	"spider":          {"mob", []string{"flags"}},
+	"enderman":        {"mob", []string{"carry_state", "creepy", "stared_at"}},
+	"blaze":           {"mob", []string{"flags"}},
+	"ghast":           {"mob", []string{"is_charging"}},
+	"slime":           {"mob", []string{"size"}},
+	"phantom":         {"mob", []string{"size"}},
+	"guardian":        {"mob", []string{"moving", "attack_target"}},
+	"raider":          {"mob", []string{"is_celebrating"}},
+	"pillager":        {"raider", []string{"is_charging_crossbow"}},
+	"spellcaster":     {"raider", []string{"spell_casting"}},
+	"witch":           {"raider", []string{"using_item"}},
+	"vex":             {"mob", []string{"flags"}},
+	"wither":          {"mob", []string{"target_a", "target_b", "target_c", "inv"}},
+	"warden":          {"mob", []string{"client_anger_level"}},
+	"zoglin":          {"mob", []string{"baby"}},
+	"ender_dragon":    {"mob", []string{"phase"}},
+	"bat":             {"mob", []string{"flags"}},
+}
+
+// typeClasses are the classes of the entity types that aren't a class of
+// their own.
+var typeClasses = map[string]string{
+	"egg":               "thrown_item",
+	"snowball":          "thrown_item",
+	"ender_pearl":       "thrown_item",
+	"potion":            "thrown_item",
+	"experience_bottle": "thrown_item",
+	"small_fireball":    "fireball",
+	"glow_item_frame":   "item_frame",
+	"spectral_arrow":    "abstract_arrow",
+	"chest_boat":        "boat",
+
+	"minecart":         "abstract_minecart",
+	"chest_minecart":   "abstract_minecart",
+	"hopper_minecart":  "abstract_minecart",
+	"spawner_minecart": "abstract_minecart",
+	"tnt_minecart":     "abstract_minecart",
+
+	"chicken":          "ageable",
+	"cow":              "ageable",
+	"donkey":           "chested_horse",
+	"mule":             "chested_horse",
+	"trader_llama":     "llama",
+	"skeleton_horse":   "abstract_horse",
+	"zombie_horse":     "abstract_horse",
+	"wandering_trader": "villager_base",
+
+	"cod":        "abstract_fish",
+	"salmon":     "abstract_fish",
+	"tadpole":    "abstract_fish",
+	"squid":      "mob",
+	"breeze":     "mob",
+	"endermite":  "mob",
+	"silverfish": "mob",
+	"giant":      "mob",
+
+	"husk":             "zombie",
+	"drowned":          "zombie",
+	"zombified_piglin": "zombie",
+	"stray":            "mob",
+	"wither_skeleton":  "mob",
+	"piglin_brute":     "piglin_base",
+	"cave_spider":      "spider",
+	"magma_cube":       "slime",
+	"elder_guardian":   "guardian",
+	"vindicator":       "raider",
+	"ravager":          "raider",
+	"evoker":           "spellcaster",
+	"illusioner":       "spellcaster",
+}
+
+// fields are the entity data field names of each type, by index.
+var fields = make([][]string, len(registryid.EntityType))
+
+func init() {
+	for id := range fields {
+		name := TypeName(int32(id))
+
+		c := name
+		if alias, ok := typeClasses[name]; ok {
+			c = alias
+		} else if _, ok := classes[name]; !ok {
+			c = "entity"
+		}
+
+		fields[id] = classFields(c)
+	}
+}
+
+func classFields(name string) []string {
+	c := classes[name]
+	if c.parent == "" {
+		return c.fields
+	}
+
+	return append(slices.Clone(classFields(c.parent)), c.fields...)
+}
+
+// Fields returns the names of the entity data fields of the entity type
+// id, by index. Types without data of their own have the fields of the
+// class they derive from.
+func Fields(id int32) []string {
+	if id < 0 || int(id) >= len(fields) {
+		return classes["entity"].fields
+	}
+
+	return fields[id]
+}
+
+// FieldIndex returns the index of the named entity data field of the
+// entity type id, or -1 if the type has no such field.
+func FieldIndex(id int32, name string) int {
+	return slices.Index(Fields(id), name)
+}
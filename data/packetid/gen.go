@@ -0,0 +1,10 @@
+package packetid
+
+// The tables are generated from the reports in data/reports: packets.json
+// and the registries the bot reads by ID out of registries.json, as the
+// data generator of a 1.21 server wrote them. To move to another version,
+// replace them with that server's reports, keeping only the registries
+// listed now, and run go generate ./data/packetid. See cmd/gendata.
+// data/block is generated from blocks.json, which is only copied in for
+// the generation as it is too large to keep.
+//go:generate go run mcAfkGo/cmd/gendata -reports ../reports -out ..
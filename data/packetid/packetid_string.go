@@ -0,0 +1,213 @@
+// Code generated by gendata from the vanilla data reports; DO NOT EDIT.
+
+package packetid
+
+import "strconv"
+
+// String returns the name of the play state packet with the ID. The IDs of
+// the other states overlap them.
+func (i ClientboundPacketID) String() string {
+	if i < 0 || int(i) >= len(_ClientboundPacketID_names) {
+		return "ClientboundPacketID(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+
+	return _ClientboundPacketID_names[i]
+}
+
+var _ClientboundPacketID_names = [...]string{
+	"BundleDelimiter",
+	"ClientboundAddEntity",
+	"ClientboundAddExperienceOrb",
+	"ClientboundAnimate",
+	"ClientboundAwardStats",
+	"ClientboundBlockChangedAck",
+	"ClientboundBlockDestruction",
+	"ClientboundBlockEntityData",
+	"ClientboundBlockEvent",
+	"ClientboundBlockUpdate",
+	"ClientboundBossEvent",
+	"ClientboundChangeDifficulty",
+	"ClientboundChunkBatchFinished",
+	"ClientboundChunkBatchStart",
+	"ClientboundChunksBiomes",
+	"ClientboundClearTitles",
+	"ClientboundCommandSuggestions",
+	"ClientboundCommands",
+	"ClientboundContainerClose",
+	"ClientboundContainerSetContent",
+	"ClientboundContainerSetData",
+	"ClientboundContainerSetSlot",
+	"ClientboundCookieRequest",
+	"ClientboundCooldown",
+	"ClientboundCustomChatCompletions",
+	"ClientboundCustomPayload",
+	"ClientboundDamageEvent",
+	"ClientboundDebugSample",
+	"ClientboundDeleteChat",
+	"ClientboundDisconnect",
+	"ClientboundDisguisedChat",
+	"ClientboundEntityEvent",
+	"ClientboundExplode",
+	"ClientboundForgetLevelChunk",
+	"ClientboundGameEvent",
+	"ClientboundHorseScreenOpen",
+	"ClientboundHurtAnimation",
+	"ClientboundInitializeBorder",
+	"ClientboundKeepAlive",
+	"ClientboundLevelChunkWithLight",
+	"ClientboundLevelEvent",
+	"ClientboundLevelParticles",
+	"ClientboundLightUpdate",
+	"ClientboundLogin",
+	"ClientboundMapItemData",
+	"ClientboundMerchantOffers",
+	"ClientboundMoveEntityPos",
+	"ClientboundMoveEntityPosRot",
+	"ClientboundMoveEntityRot",
+	"ClientboundMoveVehicle",
+	"ClientboundOpenBook",
+	"ClientboundOpenScreen",
+	"ClientboundOpenSignEditor",
+	"ClientboundPing",
+	"ClientboundPongResponse",
+	"ClientboundPlaceGhostRecipe",
+	"ClientboundPlayerAbilities",
+	"ClientboundPlayerChat",
+	"ClientboundPlayerCombatEnd",
+	"ClientboundPlayerCombatEnter",
+	"ClientboundPlayerCombatKill",
+	"ClientboundPlayerInfoRemove",
+	"ClientboundPlayerInfoUpdate",
+	"ClientboundPlayerLookAt",
+	"ClientboundPlayerPosition",
+	"ClientboundRecipe",
+	"ClientboundRemoveEntities",
+	"ClientboundRemoveMobEffect",
+	"ClientboundResetScore",
+	"ClientboundResourcePackPop",
+	"ClientboundResourcePackPush",
+	"ClientboundRespawn",
+	"ClientboundRotateHead",
+	"ClientboundSectionBlocksUpdate",
+	"ClientboundSelectAdvancementsTab",
+	"ClientboundServerData",
+	"ClientboundSetActionBarText",
+	"ClientboundSetBorderCenter",
+	"ClientboundSetBorderLerpSize",
+	"ClientboundSetBorderSize",
+	"ClientboundSetBorderWarningDelay",
+	"ClientboundSetBorderWarningDistance",
+	"ClientboundSetCamera",
+	"ClientboundSetCarriedItem",
+	"ClientboundSetChunkCacheCenter",
+	"ClientboundSetChunkCacheRadius",
+	"ClientboundSetDefaultSpawnPosition",
+	"ClientboundSetDisplayObjective",
+	"ClientboundSetEntityData",
+	"ClientboundSetEntityLink",
+	"ClientboundSetEntityMotion",
+	"ClientboundSetEquipment",
+	"ClientboundSetExperience",
+	"ClientboundSetHealth",
+	"ClientboundSetObjective",
+	"ClientboundSetPassengers",
+	"ClientboundSetPlayerTeam",
+	"ClientboundSetScore",
+	"ClientboundSetSimulationDistance",
+	"ClientboundSetSubtitleText",
+	"ClientboundSetTime",
+	"ClientboundSetTitleText",
+	"ClientboundSetTitlesAnimation",
+	"ClientboundSoundEntity",
+	"ClientboundSound",
+	"ClientboundStartConfiguration",
+	"ClientboundStopSound",
+	"ClientboundStoreCookie",
+	"ClientboundSystemChat",
+	"ClientboundTabList",
+	"ClientboundTagQuery",
+	"ClientboundTakeItemEntity",
+	"ClientboundTeleportEntity",
+	"ClientboundTickingState",
+	"ClientboundTickingStep",
+	"ClientboundTransfer",
+	"ClientboundUpdateAdvancements",
+	"ClientboundUpdateAttributes",
+	"ClientboundUpdateMobEffect",
+	"ClientboundUpdateRecipes",
+	"ClientboundUpdateTags",
+	"ClientboundProjectilePower",
+	"ClientboundCustomReportDetails",
+	"ClientboundServerLinks",
+}
+
+// String returns the name of the play state packet with the ID. The IDs of
+// the other states overlap them.
+func (i ServerboundPacketID) String() string {
+	if i < 0 || int(i) >= len(_ServerboundPacketID_names) {
+		return "ServerboundPacketID(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+
+	return _ServerboundPacketID_names[i]
+}
+
+var _ServerboundPacketID_names = [...]string{
+	"ServerboundAcceptTeleportation",
+	"ServerboundBlockEntityTagQuery",
+	"ServerboundChangeDifficulty",
+	"ServerboundChatAck",
+	"ServerboundChatCommand",
+	"ServerboundChatCommandSigned",
+	"ServerboundChat",
+	"ServerboundChatSessionUpdate",
+	"ServerboundChunkBatchReceived",
+	"ServerboundClientCommand",
+	"ServerboundClientInformation",
+	"ServerboundCommandSuggestion",
+	"ServerboundConfigurationAcknowledged",
+	"ServerboundContainerButtonClick",
+	"ServerboundContainerClick",
+	"ServerboundContainerClose",
+	"ServerboundContainerSlotStateChanged",
+	"ServerboundCookieResponse",
+	"ServerboundCustomPayload",
+	"ServerboundDebugSampleSubscription",
+	"ServerboundEditBook",
+	"ServerboundEntityTagQuery",
+	"ServerboundInteract",
+	"ServerboundJigsawGenerate",
+	"ServerboundKeepAlive",
+	"ServerboundLockDifficulty",
+	"ServerboundMovePlayerPos",
+	"ServerboundMovePlayerPosRot",
+	"ServerboundMovePlayerRot",
+	"ServerboundMovePlayerStatusOnly",
+	"ServerboundMoveVehicle",
+	"ServerboundPaddleBoat",
+	"ServerboundPickItem",
+	"ServerboundPingRequest",
+	"ServerboundPlaceRecipe",
+	"ServerboundPlayerAbilities",
+	"ServerboundPlayerAction",
+	"ServerboundPlayerCommand",
+	"ServerboundPlayerInput",
+	"ServerboundPong",
+	"ServerboundRecipeBookChangeSettings",
+	"ServerboundRecipeBookSeenRecipe",
+	"ServerboundRenameItem",
+	"ServerboundResourcePack",
+	"ServerboundSeenAdvancements",
+	"ServerboundSelectTrade",
+	"ServerboundSetBeacon",
+	"ServerboundSetCarriedItem",
+	"ServerboundSetCommandBlock",
+	"ServerboundSetCommandMinecart",
+	"ServerboundSetCreativeModeSlot",
+	"ServerboundSetJigsawBlock",
+	"ServerboundSetStructureBlock",
+	"ServerboundSignUpdate",
+	"ServerboundSwing",
+	"ServerboundTeleportToEntity",
+	"ServerboundUseItemOn",
+	"ServerboundUseItem",
+}
@@ -1,92 +0,0 @@
-package packetid
-
-import "strconv"
-
-func _() {
-	var x [1]struct{}
-	_ = x[ServerboundLoginHello-0]
-	_ = x[ServerboundLoginKey-1]
-	_ = x[ServerboundLoginCustomQueryAnswer-2]
-	_ = x[ServerboundLoginLoginAcknowledged-3]
-	_ = x[ServerboundLoginCookieResponse-4]
-	_ = x[ServerboundStatusStatusRequest-0]
-	_ = x[ServerboundStatusPingRequest-1]
-	_ = x[ServerboundConfigClientInformation-0]
-	_ = x[ServerboundConfigCookieResponse-1]
-	_ = x[ServerboundConfigCustomPayload-2]
-	_ = x[ServerboundConfigFinishConfiguration-3]
-	_ = x[ServerboundConfigKeepAlive-4]
-	_ = x[ServerboundConfigPong-5]
-	_ = x[ServerboundConfigResourcePack-6]
-	_ = x[ServerboundConfigSelectKnownPacks-7]
-	_ = x[ServerboundAcceptTeleportation-0]
-	_ = x[ServerboundBlockEntityTagQuery-1]
-	_ = x[ServerboundChangeDifficulty-2]
-	_ = x[ServerboundChatAck-3]
-	_ = x[ServerboundChatCommand-4]
-	_ = x[ServerboundChatCommandSigned-5]
-	_ = x[ServerboundChat-6]
-	_ = x[ServerboundChatSessionUpdate-7]
-	_ = x[ServerboundChunkBatchReceived-8]
-	_ = x[ServerboundClientCommand-9]
-	_ = x[ServerboundClientInformation-10]
-	_ = x[ServerboundCommandSuggestion-11]
-	_ = x[ServerboundConfigurationAcknowledged-12]
-	_ = x[ServerboundContainerButtonClick-13]
-	_ = x[ServerboundContainerClick-14]
-	_ = x[ServerboundContainerClose-15]
-	_ = x[ServerboundContainerSlotStateChanged-16]
-	_ = x[ServerboundCookieResponse-17]
-	_ = x[ServerboundCustomPayload-18]
-	_ = x[ServerboundDebugSampleSubscription-19]
-	_ = x[ServerboundEditBook-20]
-	_ = x[ServerboundEntityTagQuery-21]
-	_ = x[ServerboundInteract-22]
-	_ = x[ServerboundJigsawGenerate-23]
-	_ = x[ServerboundKeepAlive-24]
-	_ = x[ServerboundLockDifficulty-25]
-	_ = x[ServerboundMovePlayerPos-26]
-	_ = x[ServerboundMovePlayerPosRot-27]
-	_ = x[ServerboundMovePlayerRot-28]
-	_ = x[ServerboundMovePlayerStatusOnly-29]
-	_ = x[ServerboundMoveVehicle-30]
-	_ = x[ServerboundPaddleBoat-31]
-	_ = x[ServerboundPickItem-32]
-	_ = x[ServerboundPingRequest-33]
-	_ = x[ServerboundPlaceRecipe-34]
-	_ = x[ServerboundPlayerAbilities-35]
-	_ = x[ServerboundPlayerAction-36]
-	_ = x[ServerboundPlayerCommand-37]
-	_ = x[ServerboundPlayerInput-38]
-	_ = x[ServerboundPong-39]
-	_ = x[ServerboundRecipeBookChangeSettings-40]
-	_ = x[ServerboundRecipeBookSeenRecipe-41]
-	_ = x[ServerboundRenameItem-42]
-	_ = x[ServerboundResourcePack-43]
-	_ = x[ServerboundSeenAdvancements-44]
-	_ = x[ServerboundSelectTrade-45]
-	_ = x[ServerboundSetBeacon-46]
-	_ = x[ServerboundSetCarriedItem-47]
-	_ = x[ServerboundSetCommandBlock-48]
-	_ = x[ServerboundSetCommandMinecart-49]
-	_ = x[ServerboundSetCreativeModeSlot-50]
-	_ = x[ServerboundSetJigsawBlock-51]
-	_ = x[ServerboundSetStructureBlock-52]
-	_ = x[ServerboundSignUpdate-53]
-	_ = x[ServerboundSwing-54]
-	_ = x[ServerboundTeleportToEntity-55]
-	_ = x[ServerboundUseItemOn-56]
-	_ = x[ServerboundUseItem-57]
-	_ = x[ServerboundPacketIDGuard-58]
-}
-
-const _ServerboundPacketID_name = "ServerboundLoginHelloServerboundLoginKeyServerboundLoginCustomQueryAnswerServerboundLoginLoginAcknowledgedServerboundLoginCookieResponseServerboundConfigPongServerboundConfigResourcePackServerboundConfigSelectKnownPacksServerboundChunkBatchReceivedServerboundClientCommandServerboundClientInformationServerboundCommandSuggestionServerboundConfigurationAcknowledgedServerboundContainerButtonClickServerboundContainerClickServerboundContainerCloseServerboundContainerSlotStateChangedServerboundCookieResponseServerboundCustomPayloadServerboundDebugSampleSubscriptionServerboundEditBookServerboundEntityTagQueryServerboundInteractServerboundJigsawGenerateServerboundKeepAliveServerboundLockDifficultyServerboundMovePlayerPosServerboundMovePlayerPosRotServerboundMovePlayerRotServerboundMovePlayerStatusOnlyServerboundMoveVehicleServerboundPaddleBoatServerboundPickItemServerboundPingRequestServerboundPlaceRecipeServerboundPlayerAbilitiesServerboundPlayerActionServerboundPlayerCommandServerboundPlayerInputServerboundPongServerboundRecipeBookChangeSettingsServerboundRecipeBookSeenRecipeServerboundRenameItemServerboundResourcePackServerboundSeenAdvancementsServerboundSelectTradeServerboundSetBeaconServerboundSetCarriedItemServerboundSetCommandBlockServerboundSetCommandMinecartServerboundSetCreativeModeSlotServerboundSetJigsawBlockServerboundSetStructureBlockServerboundSignUpdateServerboundSwingServerboundTeleportToEntityServerboundUseItemOnServerboundUseItemServerboundPacketIDGuard"
-
-var _ServerboundPacketID_index = [...]uint16{0, 21, 40, 73, 106, 136, 157, 186, 219, 248, 272, 300, 328, 364, 395, 420, 445, 481, 506, 530, 564, 583, 608, 627, 652, 672, 697, 721, 748, 772, 803, 825, 846, 865, 887, 909, 935, 958, 982, 1004, 1019, 1054, 1085, 1106, 1129, 1156, 1178, 1198, 1223, 1249, 1278, 1308, 1333, 1361, 1382, 1398, 1425, 1445, 1463, 1487}
-
-func (i ServerboundPacketID) String() string {
-	if i < 0 || i >= ServerboundPacketID(len(_ServerboundPacketID_index)-1) {
-		return "ServerboundPacketID(" + strconv.FormatInt(int64(i), 10) + ")"
-	}
-	return _ServerboundPacketID_name[_ServerboundPacketID_index[i]:_ServerboundPacketID_index[i+1]]
-}
@@ -0,0 +1,192 @@
+package protocol
+
+import (
+	"bytes"
+	"io"
+
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/data/registryid"
+	"mcAfkGo/net/metadata"
+	pk "mcAfkGo/net/packet"
+)
+
+// play766 rewrites the play packets 766 lays out differently into the
+// layout of 767: those carrying stacks, see pk.SlotLayout766, and entity
+// data, where painting variants were plain IDs. Stacks in packets the bot
+// doesn't read, such as recipes and merchant offers, are left as sent.
+// Registry IDs, such as item and particle types, are passed through.
+var play766 = map[packetid.ClientboundPacketID]func(data []byte) ([]byte, error){
+	packetid.ClientboundContainerSetContent: rewriter(containerSetContent766),
+	packetid.ClientboundContainerSetSlot:    rewriter(containerSetSlot766),
+	packetid.ClientboundSetEntityData:       rewriter(entityData766),
+	packetid.ClientboundSetEquipment:        rewriter(equipment766),
+}
+
+// rewriter returns a rewrite of packet data by f, keeping any bytes f
+// didn't read.
+func rewriter(f func(r io.Reader, w io.Writer) error) func(data []byte) ([]byte, error) {
+	return func(data []byte) ([]byte, error) {
+		var buf bytes.Buffer
+
+		r := bytes.NewReader(data)
+		if err := f(r, &buf); err != nil {
+			return nil, err
+		}
+
+		_, _ = r.WriteTo(&buf)
+
+		return buf.Bytes(), nil
+	}
+}
+
+// copyFields reads fields from r, writing what they took to w unchanged.
+func copyFields(r io.Reader, w io.Writer, fields ...any) error {
+	_, err := pk.Tuple(fields).ReadFrom(io.TeeReader(r, w))
+
+	return err
+}
+
+// copyTagged copies a value v whose type tag the caller read and wrote
+// already. v reads the tag again from prefix.
+func copyTagged(prefix []byte, r io.Reader, w io.Writer, v pk.FieldDecoder) error {
+	_, err := v.ReadFrom(io.MultiReader(bytes.NewReader(prefix), io.TeeReader(r, w)))
+
+	return err
+}
+
+func containerSetContent766(r io.Reader, w io.Writer) error {
+	var (
+		window      pk.UnsignedByte
+		state, size pk.VarInt
+	)
+
+	err := copyFields(r, w, &window, &state, &size)
+	if err != nil {
+		return err
+	}
+
+	// The stacks are followed by the one the cursor carries.
+	for range size + 1 {
+		err = pk.SlotLayout766.ConvertSlot(r, w)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containerSetSlot766(r io.Reader, w io.Writer) error {
+	var (
+		window pk.Byte
+		state  pk.VarInt
+		slot   pk.Short
+	)
+
+	err := copyFields(r, w, &window, &state, &slot)
+	if err != nil {
+		return err
+	}
+
+	return pk.SlotLayout766.ConvertSlot(r, w)
+}
+
+func equipment766(r io.Reader, w io.Writer) error {
+	var entity pk.VarInt
+
+	err := copyFields(r, w, &entity)
+	if err != nil {
+		return err
+	}
+
+	// The top bit of the slot is set if another one follows.
+	for {
+		var slot pk.UnsignedByte
+
+		err = copyFields(r, w, &slot)
+		if err != nil {
+			return err
+		}
+
+		err = pk.SlotLayout766.ConvertSlot(r, w)
+		if err != nil || slot&0x80 == 0 {
+			return err
+		}
+	}
+}
+
+func entityData766(r io.Reader, w io.Writer) error {
+	var entity pk.VarInt
+
+	err := copyFields(r, w, &entity)
+	if err != nil {
+		return err
+	}
+
+	for {
+		var index pk.UnsignedByte
+
+		err = copyFields(r, w, &index)
+		if err != nil || index == 0xff {
+			return err
+		}
+
+		var typ pk.VarInt
+
+		err = copyFields(r, w, &typ)
+		if err != nil {
+			return err
+		}
+
+		switch metadata.Type(typ) {
+		case metadata.TypeSlot:
+			err = pk.SlotLayout766.ConvertSlot(r, w)
+		case metadata.TypePaintingVariant:
+			// 767 refers to the registry by ID plus one, 0 being an inline
+			// variant.
+			var id pk.VarInt
+			if _, err = id.ReadFrom(r); err == nil {
+				_, err = (id + 1).WriteTo(w)
+			}
+		case metadata.TypeParticle:
+			err = particle766(r, w)
+		case metadata.TypeParticles:
+			var count pk.VarInt
+
+			err = copyFields(r, w, &count)
+			for i := pk.VarInt(0); err == nil && i < count; i++ {
+				err = particle766(r, w)
+			}
+		default:
+			var tag bytes.Buffer
+			_, _ = typ.WriteTo(&tag)
+
+			err = copyTagged(tag.Bytes(), r, w, new(metadata.Value))
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// particleItem is the particle type whose options are a stack.
+var particleItem = pk.VarInt(registryid.ID(registryid.ParticleType, "minecraft:item"))
+
+func particle766(r io.Reader, w io.Writer) error {
+	var typ pk.VarInt
+
+	err := copyFields(r, w, &typ)
+	if err != nil {
+		return err
+	}
+
+	if typ == particleItem {
+		return pk.SlotLayout766.ConvertSlot(r, w)
+	}
+
+	var tag bytes.Buffer
+	_, _ = typ.WriteTo(&tag)
+
+	return copyTagged(tag.Bytes(), r, w, new(pk.Particle))
+}
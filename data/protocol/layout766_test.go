@@ -0,0 +1,90 @@
+package protocol
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/net/capture"
+	"mcAfkGo/net/metadata"
+	pk "mcAfkGo/net/packet"
+)
+
+func TestRewriteEntityData766(t *testing.T) {
+	// A stack as 766 sends it: ominous_bottle_amplifier, recipes (42 in
+	// 766, 43 in 767), food without using_converts_to and an attribute
+	// modifier named by UUID, and the jukebox-less ID of lock removed.
+	modifier := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	var stack bytes.Buffer
+	_, _ = pk.Tuple{
+		pk.VarInt(1), pk.VarInt(800), pk.VarInt(4), pk.VarInt(1),
+		pk.VarInt(41), pk.VarInt(3),
+		pk.VarInt(42), pk.NBT(map[string]any{}),
+		pk.VarInt(20), pk.VarInt(4), pk.Float(2.4), pk.Boolean(false), pk.Float(1.6), pk.VarInt(0),
+		pk.VarInt(12), pk.VarInt(1),
+		pk.VarInt(5), pk.UUID(modifier), pk.String("Weapon modifier"), pk.Double(3), pk.VarInt(0), pk.VarInt(1),
+		pk.Boolean(true),
+		pk.VarInt(54),
+	}.WriteTo(&stack)
+
+	p := pk.Marshal(packetid.ClientboundSetEntityData,
+		pk.VarInt(7),
+		pk.UnsignedByte(8), pk.VarInt(metadata.TypeSlot), pk.PluginMessageData(stack.Bytes()),
+		pk.UnsignedByte(9), pk.VarInt(metadata.TypePaintingVariant), pk.VarInt(4),
+		pk.UnsignedByte(0xff),
+	)
+
+	V1_20_5.Mapper(capture.StatePlay).RewriteInbound(&p)
+
+	var (
+		id   pk.VarInt
+		meta metadata.Metadata
+	)
+
+	if err := p.Scan(&id, &meta); err != nil {
+		t.Fatalf("rewritten packet doesn't decode as 767: %v", err)
+	}
+
+	slot, ok := metadata.Get[pk.Slot](meta, 8)
+	if !ok {
+		t.Fatal("no stack")
+	}
+
+	var types []int32
+	for _, c := range slot.Components {
+		types = append(types, c.Type)
+	}
+
+	if want := []int32{41, 43, 20, 12}; !slices.Equal(types, want) {
+		t.Errorf("component types = %v, want %v", types, want)
+	}
+
+	if want := []int32{55}; !slices.Equal(slot.Removed, want) {
+		t.Errorf("removed types = %v, want %v", slot.Removed, want)
+	}
+
+	var (
+		attribute, operation, equipSlot pk.VarInt
+		count                           pk.VarInt
+		name                            pk.Identifier
+		amount                          pk.Double
+	)
+
+	_, err := pk.Tuple{&count, &attribute, &name, &amount, &operation, &equipSlot}.ReadFrom(bytes.NewReader(slot.Component(12)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name != pk.Identifier("minecraft:"+modifier.String()) || amount != 3 {
+		t.Errorf("modifier = %s %v", name, amount)
+	}
+
+	painting, _ := metadata.Get[metadata.PaintingVariant](meta, 9)
+	if painting.ID != 4 {
+		t.Errorf("painting variant = %d, want 4", painting.ID)
+	}
+}
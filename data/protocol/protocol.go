@@ -0,0 +1,149 @@
+// Package protocol describes the protocol versions the bot can speak.
+//
+// The constants in packetid are the bot's logical packet identities; they
+// are numbered and laid out like protocol 767 (1.21). Every Version maps
+// them to the IDs it uses on the wire and rewrites the packets it lays out
+// differently, so handlers are written once against packetid.
+package protocol
+
+import (
+	"slices"
+	"strconv"
+
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/net/capture"
+	pk "mcAfkGo/net/packet"
+)
+
+// Unknown is the ID inbound packets get when the version has a packet the
+// bot has no identity for.
+const Unknown = -1
+
+// Version is a protocol version with its packet IDs.
+type Version struct {
+	Protocol int32
+	Names    []string
+
+	// clientbound and serverbound list the packets of each state in wire
+	// order, the wire ID being the index.
+	clientbound map[capture.State][]packetid.ClientboundPacketID
+	serverbound map[capture.State][]packetid.ServerboundPacketID
+	// rewrite rewrites the data of the clientbound packets, by logical ID,
+	// the version lays out differently from 767 into 767's layout.
+	rewrite map[capture.State]map[packetid.ClientboundPacketID]func(data []byte) ([]byte, error)
+}
+
+func (v *Version) String() string {
+	if len(v.Names) == 0 {
+		return "protocol " + strconv.Itoa(int(v.Protocol))
+	}
+
+	return v.Names[0] + " (protocol " + strconv.Itoa(int(v.Protocol)) + ")"
+}
+
+// Has reports whether the version knows the clientbound packet id.
+func (v *Version) Has(state capture.State, id packetid.ClientboundPacketID) bool {
+	return slices.Contains(v.clientbound[state], id)
+}
+
+// Mapper translates the packet IDs and layouts of state, or returns nil if
+// the version's are the logical ones.
+func (v *Version) Mapper(state capture.State) *Mapper {
+	in, out, rewrite := v.clientbound[state], v.serverbound[state], v.rewrite[state]
+	if isIdentity(in) && isIdentity(out) && len(rewrite) == 0 {
+		return nil
+	}
+
+	m := &Mapper{
+		inbound:  make([]int32, len(in)),
+		outbound: make(map[int32]int32, len(out)),
+		rewrite:  make(map[int32]func(data []byte) ([]byte, error), len(rewrite)),
+	}
+
+	for id, f := range rewrite {
+		m.rewrite[int32(id)] = f
+	}
+
+	for wire, id := range in {
+		m.inbound[wire] = int32(id)
+	}
+
+	for wire, id := range out {
+		m.outbound[int32(id)] = int32(wire)
+	}
+
+	return m
+}
+
+// Mapper translates packet IDs of one state between the wire and packetid,
+// and rewrites the packets the version lays out differently.
+type Mapper struct {
+	inbound  []int32
+	outbound map[int32]int32
+	rewrite  map[int32]func(data []byte) ([]byte, error)
+}
+
+// Inbound returns the logical ID of a packet received with wire ID id, or
+// Unknown.
+func (m *Mapper) Inbound(id int32) int32 {
+	if id < 0 || int(id) >= len(m.inbound) {
+		return Unknown
+	}
+
+	return m.inbound[id]
+}
+
+// Outbound returns the wire ID of the logical packet id, false if the
+// version doesn't have it.
+func (m *Mapper) Outbound(id int32) (int32, bool) {
+	wire, ok := m.outbound[id]
+
+	return wire, ok
+}
+
+// RewriteInbound rewrites the data of a received packet, its ID translated
+// already, into the layout of 767. A packet it can't rewrite is left as
+// received, for its handler to report.
+func (m *Mapper) RewriteInbound(p *pk.Packet) {
+	rewrite, ok := m.rewrite[p.ID]
+	if !ok {
+		return
+	}
+
+	data, err := rewrite(p.Data)
+	if err == nil {
+		p.Data = data
+	}
+}
+
+func isIdentity[T ~int32](ids []T) bool {
+	for i, id := range ids {
+		if int32(id) != int32(i) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Lookup returns the supported version with the given protocol number.
+func Lookup(protocol int32) (*Version, bool) {
+	for _, v := range versions {
+		if v.Protocol == protocol {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// Supported lists the versions the bot speaks, newest first.
+func Supported() []*Version {
+	return slices.Clone(versions)
+}
+
+// Latest is the newest supported version, the one packetid is numbered
+// after.
+var Latest = V1_21
+
+var versions = []*Version{V1_21, V1_20_5}
@@ -0,0 +1,55 @@
+package protocol
+
+import (
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/net/capture"
+)
+
+// V1_21 is protocol 767, used by 1.21 and 1.21.1.
+var V1_21 = &Version{
+	Protocol: 767,
+	Names:    []string{"1.21", "1.21.1"},
+	clientbound: map[capture.State][]packetid.ClientboundPacketID{
+		capture.StateLogin:         span(packetid.ClientboundLoginLoginDisconnect, packetid.ClientboundLoginCookieRequest),
+		capture.StateConfiguration: span(packetid.ClientboundConfigCookieRequest, packetid.ClientboundConfigServerLinks),
+		capture.StatePlay:          span(packetid.BundleDelimiter, packetid.ClientboundServerLinks),
+	},
+	serverbound: map[capture.State][]packetid.ServerboundPacketID{
+		capture.StateLogin:         span(packetid.ServerboundLoginHello, packetid.ServerboundLoginCookieResponse),
+		capture.StateConfiguration: span(packetid.ServerboundConfigClientInformation, packetid.ServerboundConfigSelectKnownPacks),
+		capture.StatePlay:          span(packetid.ServerboundAcceptTeleportation, packetid.ServerboundUseItem),
+	},
+}
+
+// V1_20_5 is protocol 766, used by 1.20.5 and 1.20.6. It lacks the custom
+// report details and server links packets 1.21 appended to the
+// configuration and play states, and lays out stacks and entity data a
+// little differently, see play766. Its serverbound packets are those of
+// 1.21.
+var V1_20_5 = &Version{
+	Protocol: 766,
+	Names:    []string{"1.20.5", "1.20.6"},
+	clientbound: map[capture.State][]packetid.ClientboundPacketID{
+		capture.StateLogin:         span(packetid.ClientboundLoginLoginDisconnect, packetid.ClientboundLoginCookieRequest),
+		capture.StateConfiguration: span(packetid.ClientboundConfigCookieRequest, packetid.ClientboundConfigSelectKnownPacks),
+		capture.StatePlay:          span(packetid.BundleDelimiter, packetid.ClientboundProjectilePower),
+	},
+	serverbound: map[capture.State][]packetid.ServerboundPacketID{
+		capture.StateLogin:         span(packetid.ServerboundLoginHello, packetid.ServerboundLoginCookieResponse),
+		capture.StateConfiguration: span(packetid.ServerboundConfigClientInformation, packetid.ServerboundConfigSelectKnownPacks),
+		capture.StatePlay:          span(packetid.ServerboundAcceptTeleportation, packetid.ServerboundUseItem),
+	},
+	rewrite: map[capture.State]map[packetid.ClientboundPacketID]func(data []byte) ([]byte, error){
+		capture.StatePlay: play766,
+	},
+}
+
+// span lists the IDs from first to last.
+func span[T ~int32](first, last T) []T {
+	ids := make([]T, 0, last-first+1)
+	for id := first; id <= last; id++ {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
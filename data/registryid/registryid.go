@@ -0,0 +1,326 @@
+// Code generated by gendata from the vanilla data reports; DO NOT EDIT.
+
+// Package registryid lists the entries of the game's built-in registries,
+// indexed by their network ID.
+package registryid
+
+import "slices"
+
+// ID returns the network ID of the named entry of a registry, or -1.
+func ID(entries []string, name string) int32 {
+	return int32(slices.Index(entries, name))
+}
+
+var (
+	DataComponentType = []string{
+		"minecraft:custom_data",
+		"minecraft:max_stack_size",
+		"minecraft:max_damage",
+		"minecraft:damage",
+		"minecraft:unbreakable",
+		"minecraft:custom_name",
+		"minecraft:item_name",
+		"minecraft:lore",
+		"minecraft:rarity",
+		"minecraft:enchantments",
+		"minecraft:can_place_on",
+		"minecraft:can_break",
+		"minecraft:attribute_modifiers",
+		"minecraft:custom_model_data",
+		"minecraft:hide_additional_tooltip",
+		"minecraft:hide_tooltip",
+		"minecraft:repair_cost",
+		"minecraft:creative_slot_lock",
+		"minecraft:enchantment_glint_override",
+		"minecraft:intangible_projectile",
+		"minecraft:food",
+		"minecraft:fire_resistant",
+		"minecraft:tool",
+		"minecraft:stored_enchantments",
+		"minecraft:dyed_color",
+		"minecraft:map_color",
+		"minecraft:map_id",
+		"minecraft:map_decorations",
+		"minecraft:map_post_processing",
+		"minecraft:charged_projectiles",
+		"minecraft:bundle_contents",
+		"minecraft:potion_contents",
+		"minecraft:suspicious_stew_effects",
+		"minecraft:writable_book_content",
+		"minecraft:written_book_content",
+		"minecraft:trim",
+		"minecraft:debug_stick_state",
+		"minecraft:entity_data",
+		"minecraft:bucket_entity_data",
+		"minecraft:block_entity_data",
+		"minecraft:instrument",
+		"minecraft:ominous_bottle_amplifier",
+		"minecraft:jukebox_playable",
+		"minecraft:recipes",
+		"minecraft:lodestone_tracker",
+		"minecraft:firework_explosion",
+		"minecraft:fireworks",
+		"minecraft:profile",
+		"minecraft:note_block_sound",
+		"minecraft:banner_patterns",
+		"minecraft:base_color",
+		"minecraft:pot_decorations",
+		"minecraft:container",
+		"minecraft:block_state",
+		"minecraft:bees",
+		"minecraft:lock",
+		"minecraft:container_loot",
+	}
+
+	EntityType = []string{
+		"minecraft:allay",
+		"minecraft:area_effect_cloud",
+		"minecraft:armadillo",
+		"minecraft:armor_stand",
+		"minecraft:arrow",
+		"minecraft:axolotl",
+		"minecraft:bat",
+		"minecraft:bee",
+		"minecraft:blaze",
+		"minecraft:block_display",
+		"minecraft:boat",
+		"minecraft:bogged",
+		"minecraft:breeze",
+		"minecraft:breeze_wind_charge",
+		"minecraft:camel",
+		"minecraft:cat",
+		"minecraft:cave_spider",
+		"minecraft:chest_boat",
+		"minecraft:chest_minecart",
+		"minecraft:chicken",
+		"minecraft:cod",
+		"minecraft:command_block_minecart",
+		"minecraft:cow",
+		"minecraft:creeper",
+		"minecraft:dolphin",
+		"minecraft:donkey",
+		"minecraft:dragon_fireball",
+		"minecraft:drowned",
+		"minecraft:egg",
+		"minecraft:elder_guardian",
+		"minecraft:end_crystal",
+		"minecraft:ender_dragon",
+		"minecraft:ender_pearl",
+		"minecraft:enderman",
+		"minecraft:endermite",
+		"minecraft:evoker",
+		"minecraft:evoker_fangs",
+		"minecraft:experience_bottle",
+		"minecraft:experience_orb",
+		"minecraft:eye_of_ender",
+		"minecraft:falling_block",
+		"minecraft:firework_rocket",
+		"minecraft:fox",
+		"minecraft:frog",
+		"minecraft:furnace_minecart",
+		"minecraft:ghast",
+		"minecraft:giant",
+		"minecraft:glow_item_frame",
+		"minecraft:glow_squid",
+		"minecraft:goat",
+		"minecraft:guardian",
+		"minecraft:hoglin",
+		"minecraft:hopper_minecart",
+		"minecraft:horse",
+		"minecraft:husk",
+		"minecraft:illusioner",
+		"minecraft:interaction",
+		"minecraft:iron_golem",
+		"minecraft:item",
+		"minecraft:item_display",
+		"minecraft:item_frame",
+		"minecraft:ominous_item_spawner",
+		"minecraft:fireball",
+		"minecraft:leash_knot",
+		"minecraft:lightning_bolt",
+		"minecraft:llama",
+		"minecraft:llama_spit",
+		"minecraft:magma_cube",
+		"minecraft:marker",
+		"minecraft:minecart",
+		"minecraft:mooshroom",
+		"minecraft:mule",
+		"minecraft:ocelot",
+		"minecraft:painting",
+		"minecraft:panda",
+		"minecraft:parrot",
+		"minecraft:phantom",
+		"minecraft:pig",
+		"minecraft:piglin",
+		"minecraft:piglin_brute",
+		"minecraft:pillager",
+		"minecraft:polar_bear",
+		"minecraft:potion",
+		"minecraft:pufferfish",
+		"minecraft:rabbit",
+		"minecraft:ravager",
+		"minecraft:salmon",
+		"minecraft:sheep",
+		"minecraft:shulker",
+		"minecraft:shulker_bullet",
+		"minecraft:silverfish",
+		"minecraft:skeleton",
+		"minecraft:skeleton_horse",
+		"minecraft:slime",
+		"minecraft:small_fireball",
+		"minecraft:sniffer",
+		"minecraft:snow_golem",
+		"minecraft:snowball",
+		"minecraft:spawner_minecart",
+		"minecraft:spectral_arrow",
+		"minecraft:spider",
+		"minecraft:squid",
+		"minecraft:stray",
+		"minecraft:strider",
+		"minecraft:tadpole",
+		"minecraft:text_display",
+		"minecraft:tnt",
+		"minecraft:tnt_minecart",
+		"minecraft:trader_llama",
+		"minecraft:trident",
+		"minecraft:tropical_fish",
+		"minecraft:turtle",
+		"minecraft:vex",
+		"minecraft:villager",
+		"minecraft:vindicator",
+		"minecraft:wandering_trader",
+		"minecraft:warden",
+		"minecraft:wind_charge",
+		"minecraft:witch",
+		"minecraft:wither",
+		"minecraft:wither_skeleton",
+		"minecraft:wither_skull",
+		"minecraft:wolf",
+		"minecraft:zoglin",
+		"minecraft:zombie",
+		"minecraft:zombie_horse",
+		"minecraft:zombie_villager",
+		"minecraft:zombified_piglin",
+		"minecraft:player",
+		"minecraft:fishing_bobber",
+	}
+
+	ParticleType = []string{
+		"minecraft:angry_villager",
+		"minecraft:block",
+		"minecraft:block_marker",
+		"minecraft:bubble",
+		"minecraft:cloud",
+		"minecraft:crit",
+		"minecraft:damage_indicator",
+		"minecraft:dragon_breath",
+		"minecraft:dripping_lava",
+		"minecraft:falling_lava",
+		"minecraft:landing_lava",
+		"minecraft:dripping_water",
+		"minecraft:falling_water",
+		"minecraft:dust",
+		"minecraft:dust_color_transition",
+		"minecraft:effect",
+		"minecraft:elder_guardian",
+		"minecraft:enchanted_hit",
+		"minecraft:enchant",
+		"minecraft:end_rod",
+		"minecraft:entity_effect",
+		"minecraft:explosion_emitter",
+		"minecraft:explosion",
+		"minecraft:gust",
+		"minecraft:small_gust",
+		"minecraft:gust_emitter_large",
+		"minecraft:gust_emitter_small",
+		"minecraft:sonic_boom",
+		"minecraft:falling_dust",
+		"minecraft:firework",
+		"minecraft:fishing",
+		"minecraft:flame",
+		"minecraft:infested",
+		"minecraft:cherry_leaves",
+		"minecraft:sculk_soul",
+		"minecraft:sculk_charge",
+		"minecraft:sculk_charge_pop",
+		"minecraft:soul_fire_flame",
+		"minecraft:soul",
+		"minecraft:flash",
+		"minecraft:happy_villager",
+		"minecraft:composter",
+		"minecraft:heart",
+		"minecraft:instant_effect",
+		"minecraft:item",
+		"minecraft:vibration",
+		"minecraft:item_slime",
+		"minecraft:item_cobweb",
+		"minecraft:item_snowball",
+		"minecraft:large_smoke",
+		"minecraft:lava",
+		"minecraft:mycelium",
+		"minecraft:note",
+		"minecraft:poof",
+		"minecraft:portal",
+		"minecraft:rain",
+		"minecraft:smoke",
+		"minecraft:white_smoke",
+		"minecraft:sneeze",
+		"minecraft:spit",
+		"minecraft:squid_ink",
+		"minecraft:sweep_attack",
+		"minecraft:totem_of_undying",
+		"minecraft:underwater",
+		"minecraft:splash",
+		"minecraft:witch",
+		"minecraft:bubble_pop",
+		"minecraft:current_down",
+		"minecraft:bubble_column_up",
+		"minecraft:nautilus",
+		"minecraft:dolphin",
+		"minecraft:campfire_cosy_smoke",
+		"minecraft:campfire_signal_smoke",
+		"minecraft:dripping_honey",
+		"minecraft:falling_honey",
+		"minecraft:landing_honey",
+		"minecraft:falling_nectar",
+		"minecraft:falling_spore_blossom",
+		"minecraft:ash",
+		"minecraft:crimson_spore",
+		"minecraft:warped_spore",
+		"minecraft:spore_blossom_air",
+		"minecraft:dripping_obsidian_tear",
+		"minecraft:falling_obsidian_tear",
+		"minecraft:landing_obsidian_tear",
+		"minecraft:reverse_portal",
+		"minecraft:white_ash",
+		"minecraft:small_flame",
+		"minecraft:snowflake",
+		"minecraft:dripping_dripstone_lava",
+		"minecraft:falling_dripstone_lava",
+		"minecraft:dripping_dripstone_water",
+		"minecraft:falling_dripstone_water",
+		"minecraft:glow_squid_ink",
+		"minecraft:glow",
+		"minecraft:wax_on",
+		"minecraft:wax_off",
+		"minecraft:electric_spark",
+		"minecraft:scrape",
+		"minecraft:shriek",
+		"minecraft:egg_crack",
+		"minecraft:dust_plume",
+		"minecraft:trial_spawner_detection",
+		"minecraft:trial_spawner_detection_ominous",
+		"minecraft:vault_connection",
+		"minecraft:dust_pillar",
+		"minecraft:ominous_spawning",
+		"minecraft:raid_omen",
+		"minecraft:trial_omen",
+	}
+)
+
+// Registries maps registry names to their entries.
+var Registries = map[string][]string{
+	"minecraft:data_component_type": DataComponentType,
+	"minecraft:entity_type":         EntityType,
+	"minecraft:particle_type":       ParticleType,
+}
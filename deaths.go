@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+
+	"mcAfkGo/api"
+)
+
+// loadDeaths returns the last max deaths of a deaths file, oldest first. A
+// missing file has none, lines that don't parse, like one cut short by a
+// crash, are skipped.
+func loadDeaths(path string, max int) ([]api.Death, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = f.Close() }()
+
+	var deaths []api.Death
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var d api.Death
+		if json.Unmarshal(sc.Bytes(), &d) != nil {
+			continue
+		}
+
+		deaths = append(deaths, d)
+		if len(deaths) > max {
+			deaths = deaths[len(deaths)-max:]
+		}
+	}
+
+	return deaths, sc.Err()
+}
+
+// appendDeath adds d to the deaths file as a line of JSON.
+func appendDeath(path string, d api.Death) error {
+	line, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(append(line, '\n'))
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
@@ -14,6 +14,135 @@ function timeAgo(iso) {
 }
 
 let onlinePlayers = [];
+let botsTimer = null;
+
+async function fetchBots() {
+  let pending = false;
+  try {
+    const res = await fetch('/bots');
+    if (!res.ok) {
+      renderBotsError();
+    } else {
+      const data = await res.json();
+      const bots = (data && data.bots) || [];
+      pending = bots.some(b => b.device_auth && b.device_auth.status === 'pending');
+      renderBots(bots);
+    }
+  } catch (e) {
+    renderBotsError();
+  }
+  // Poll faster while somebody may be entering a device code.
+  clearTimeout(botsTimer);
+  botsTimer = setTimeout(fetchBots, pending ? 5000 : 60000);
+}
+
+async function restartDeviceAuth(id, button) {
+  button.disabled = true;
+  try {
+    const res = await fetch('/bots/' + encodeURIComponent(id) + '/device-auth/restart', { method: 'POST' });
+    if (!res.ok) {
+      const data = await res.json().catch(() => null);
+      alert((data && data.error) || 'Failed to restart login');
+    }
+  } catch (e) {
+    alert('Failed to restart login');
+  }
+  await fetchBots();
+}
+
+function renderDeviceAuth(t, b) {
+  const d = b.device_auth;
+  const loginNeeded = b.state === 'login_required' || (b.auth && b.auth.state === 'login_required');
+  if (d && d.status === 'pending') {
+    const a = document.createElement('div');
+    a.className = 'small';
+    const link = document.createElement('a');
+    link.href = d.verification_uri_complete || d.verification_uri;
+    link.target = '_blank';
+    link.rel = 'noopener';
+    link.textContent = d.verification_uri;
+    a.appendChild(document.createTextNode('Login: open '));
+    a.appendChild(link);
+    a.appendChild(document.createTextNode(' and enter '));
+    const code = document.createElement('strong');
+    code.textContent = d.user_code;
+    a.appendChild(code);
+    t.appendChild(a);
+    const e = document.createElement('div');
+    e.className = 'small';
+    e.textContent = 'code expires ' + new Date(d.expires_at).toLocaleTimeString() +
+      (d.last_polled_at ? ', last checked ' + timeAgo(d.last_polled_at) : '');
+    t.appendChild(e);
+  } else if (d && loginNeeded && d.status !== 'completed') {
+    const a = document.createElement('div');
+    a.className = 'small';
+    a.textContent = 'Device login ' + d.status + (d.error ? ': ' + d.error : '');
+    t.appendChild(a);
+  }
+  if ((d && d.status === 'pending') || loginNeeded) {
+    const btn = document.createElement('button');
+    btn.className = 'btn';
+    btn.textContent = 'Restart login';
+    btn.addEventListener('click', () => restartDeviceAuth(b.id, btn));
+    t.appendChild(btn);
+  }
+}
+
+function renderBots(bots) {
+  const c = document.getElementById('bots');
+  c.innerHTML = '';
+  if (bots.length === 0) {
+    const t = document.createElement('div');
+    t.className = 'tile offline-tile';
+    t.textContent = 'No bots configured';
+    c.appendChild(t);
+    return;
+  }
+  bots.forEach(b => {
+    const t = document.createElement('div');
+    t.className = b.state === 'online' ? 'tile' : 'tile offline-tile';
+    const name = document.createElement('div');
+    name.className = 'player-name';
+    name.textContent = b.name ? b.id + ' (' + b.name + ')' : b.id;
+    const status = document.createElement('div');
+    status.className = 'player-status';
+    status.textContent = b.state + ' since ' + timeAgo(b.since);
+    const addr = document.createElement('div');
+    addr.className = 'small';
+    addr.textContent = b.error ? b.address + ' - ' + b.error : b.address;
+    t.appendChild(name);
+    t.appendChild(status);
+    t.appendChild(addr);
+    if (b.alert) {
+      const a = document.createElement('div');
+      a.className = 'small';
+      a.textContent = 'Alert: ' + b.alert;
+      t.appendChild(a);
+    }
+    if (b.auth && b.auth.state === 'login_required') {
+      const a = document.createElement('div');
+      a.className = 'small';
+      a.textContent = 'Refresh token revoked, interactive login required';
+      t.appendChild(a);
+    } else if (b.auth && b.auth.expires_at) {
+      const a = document.createElement('div');
+      a.className = 'small';
+      a.textContent = 'token ' + b.auth.state + ', expires ' + new Date(b.auth.expires_at).toLocaleString();
+      t.appendChild(a);
+    }
+    renderDeviceAuth(t, b);
+    c.appendChild(t);
+  });
+}
+
+function renderBotsError() {
+  const c = document.getElementById('bots');
+  c.innerHTML = '';
+  const t = document.createElement('div');
+  t.className = 'tile offline-tile';
+  t.textContent = 'Failed to load bots';
+  c.appendChild(t);
+}
 
 async function fetchOnline() {
   try {
@@ -114,8 +243,9 @@ function renderOffline(data) {
 
 document.getElementById('refresh').addEventListener('click', async () => {
   document.getElementById('offline-list').textContent = 'Loading...';
-  await fetchOnline();
+  await Promise.all([fetchBots(), fetchOnline()]);
 });
 
+fetchBots();
 fetchOnline();
 setInterval(fetchOnline, 60000);
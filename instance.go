@@ -0,0 +1,643 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"mcAfkGo/api"
+	"mcAfkGo/auth"
+	"mcAfkGo/bot"
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/bot/entities"
+	"mcAfkGo/bot/physics"
+	"mcAfkGo/bot/world"
+	"mcAfkGo/data/protocol"
+	"mcAfkGo/maths"
+	"mcAfkGo/net/capture"
+	"mcAfkGo/net/inspect"
+	pk "mcAfkGo/net/packet"
+	"mcAfkGo/net/queue"
+)
+
+// packetErrorWindow is the time MaxPacketErrors are counted in.
+const packetErrorWindow = time.Minute
+
+// maxDeaths is how many deaths a bot keeps for the deaths endpoint. The
+// deaths file keeps them all.
+const maxDeaths = 100
+
+const (
+	StateStarting       = "starting"
+	StateAuthenticating = "authenticating"
+	StateLoginRequired  = "login_required"
+	StateWaiting        = "waiting"
+	StateConnecting     = "connecting"
+	StateOnline         = "online"
+	StateDisconnected   = "disconnected"
+	StateError          = "error"
+)
+
+const (
+	reconnectDelay     = time.Minute
+	onlineCheckBackoff = time.Minute
+)
+
+// Instance is a single supervised bot account. Every instance owns its own
+// client, token cache and settings so several of them can share a process.
+type Instance struct {
+	config        BotConfig
+	settings      basic.Settings
+	authenticator auth.Authenticator
+	lastSeen      *LastSeenTracker
+	logger        *log.Logger
+
+	// tap sees the packets of every session, packets keeps the last ones
+	// for the debug endpoint.
+	tap     capture.Tap
+	packets *inspect.Ring
+
+	// wake cuts the delay before the next login attempt short.
+	wake chan struct{}
+
+	mu      sync.Mutex
+	client  *bot.Client
+	player  *basic.Player
+	world   *world.World
+	tracker *entities.Tracker
+	physics *physics.Physics
+	name    string
+	state   string
+	since   time.Time
+	lastErr error
+	alert   string
+	// recvQueue and sendQueue are the packet queues of the session.
+	recvQueue, sendQueue *queue.RingQueue[pk.Packet]
+	// deaths are the last maxDeaths deaths, oldest first.
+	deaths []api.Death
+
+	// cancelRecovery stops returning to the AFK spot after the last death.
+	cancelRecovery context.CancelFunc
+}
+
+func NewInstance(config BotConfig, lastSeen *LastSeenTracker) (*Instance, error) {
+	settings, err := config.settings()
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Instance{
+		config:        config,
+		settings:      settings,
+		authenticator: config.authenticator(),
+		lastSeen:      lastSeen,
+		logger:        log.New(os.Stderr, "["+config.ID+"] ", log.LstdFlags),
+		wake:          make(chan struct{}, 1),
+		state:         StateStarting,
+		since:         time.Now(),
+	}
+
+	b.deaths, err = loadDeaths(config.DeathsFile, maxDeaths)
+	if err != nil {
+		return nil, fmt.Errorf("bot %q: %w", config.ID, err)
+	}
+
+	var taps []capture.Tap
+	if config.CaptureFile != "" {
+		recorder, err := capture.NewRecorder(config.CaptureFile, config.CaptureMaxBytes, config.CaptureMaxFiles)
+		if err != nil {
+			return nil, fmt.Errorf("bot %q: %w", config.ID, err)
+		}
+
+		taps = append(taps, recorder)
+	}
+
+	if config.DebugPackets > 0 {
+		b.packets = inspect.NewRing(config.DebugPackets)
+		taps = append(taps, b.packets)
+	}
+
+	if config.DebugLog {
+		taps = append(taps, inspect.NewLogger(b.logger))
+	}
+
+	if len(taps) > 0 {
+		b.tap = capture.MultiTap(taps...)
+	}
+
+	return b, nil
+}
+
+func (b *Instance) ID() string { return b.config.ID }
+
+func (b *Instance) Address() string { return b.config.Address }
+
+func (b *Instance) LastSeen() map[string]time.Time { return b.lastSeen.LastSeen() }
+
+// RecentPackets returns the last n packets of the bot decoded, or nil when
+// debug_packets is off.
+func (b *Instance) RecentPackets(n int) []inspect.Packet {
+	if b.packets == nil {
+		return nil
+	}
+
+	return b.packets.Packets(n)
+}
+
+// Goto has the bot walk to x, y, z, which becomes its AFK spot once there.
+func (b *Instance) Goto(x, y, z float64) error {
+	ph, err := b.movement()
+	if err != nil {
+		return err
+	}
+
+	err = ph.Goto(maths.Vec3{X: x, Y: y, Z: z})
+	if err != nil {
+		return err
+	}
+
+	b.logger.Printf("Walking to %.1f %.1f %.1f", x, y, z)
+
+	return nil
+}
+
+// StopGoto stops walking, the bot stays where it is.
+func (b *Instance) StopGoto() error {
+	ph, err := b.movement()
+	if err != nil {
+		return err
+	}
+
+	ph.StopGoto()
+
+	return nil
+}
+
+// movement returns the physics of the bot while it is online.
+func (b *Instance) movement() (*physics.Physics, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state != StateOnline {
+		return nil, fmt.Errorf("bot is %s, not in the world", b.state)
+	}
+
+	return b.physics, nil
+}
+
+// Deaths returns the bot's last deaths, oldest first.
+func (b *Instance) Deaths() []api.Death {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]api.Death{}, b.deaths...)
+}
+
+func (b *Instance) Status() api.Status {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	status := api.Status{
+		ID:      b.config.ID,
+		Address: b.config.Address,
+		Name:    b.name,
+		State:   b.state,
+		Since:   b.since,
+	}
+
+	if b.lastErr != nil {
+		status.Error = b.lastErr.Error()
+	}
+
+	status.Alert = b.alert
+
+	if b.state == StateOnline && b.recvQueue != nil {
+		status.Queues = &api.QueueStats{Receive: b.recvQueue.Stats(), Send: b.sendQueue.Stats()}
+	}
+
+	if keeper, ok := b.authenticator.(tokenKeeper); ok {
+		state := keeper.State()
+		status.Auth = &state
+	}
+
+	if device, ok := b.authenticator.(deviceAuthenticator); ok {
+		status.DeviceAuth = device.DeviceAuth()
+	}
+
+	return status
+}
+
+// RestartDeviceAuth starts the device code login over with a new code, or
+// starts it right away when the bot is waiting to retry a failed login.
+func (b *Instance) RestartDeviceAuth() error {
+	device, ok := b.authenticator.(deviceAuthenticator)
+	if !ok {
+		return errors.New("bot does not log in with a device code")
+	}
+
+	if device.RestartDeviceAuth() {
+		b.logger.Println("Device code login restarted from the API")
+
+		return nil
+	}
+
+	b.mu.Lock()
+	state := b.state
+	b.mu.Unlock()
+
+	if state != StateLoginRequired && state != StateError {
+		return fmt.Errorf("bot is %s, no login to restart", state)
+	}
+
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
+
+	b.logger.Println("Login retry requested from the API")
+
+	return nil
+}
+
+func (b *Instance) setState(state string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = state
+	b.since = time.Now()
+	b.lastErr = err
+}
+
+// setAlert sets the problem that needs a look, "" once it is solved.
+func (b *Instance) setAlert(alert string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.alert = alert
+}
+
+// tokenKeeper is implemented by authenticators that renew their token in
+// the background.
+type tokenKeeper interface {
+	KeepFresh(ctx context.Context)
+	State() auth.TokenState
+}
+
+// deviceAuthenticator is implemented by authenticators that log in with a
+// device code somebody has to enter.
+type deviceAuthenticator interface {
+	DeviceAuth() *auth.DeviceAuthState
+	RestartDeviceAuth() bool
+}
+
+// Run connects the bot and keeps it connected, reconnecting whenever the
+// session ends. It never returns.
+func (b *Instance) Run() {
+	if keeper, ok := b.authenticator.(tokenKeeper); ok {
+		go keeper.KeepFresh(context.Background())
+	}
+
+	for {
+		err := b.connect()
+		if errors.Is(err, auth.ErrLoginRequired) {
+			b.setState(StateLoginRequired, err)
+			b.logger.Printf("Connect failed: %v", err)
+			b.logger.Println("Bot will try again in a minute")
+			b.sleep(reconnectDelay)
+
+			continue
+		}
+
+		if err != nil {
+			b.setState(StateError, err)
+			b.logger.Printf("Connect failed: %v", err)
+			b.logger.Println("Bot will try again in a minute")
+			b.sleep(reconnectDelay)
+
+			continue
+		}
+
+		err = b.handleGame()
+		b.setState(StateDisconnected, err)
+
+		if errors.Is(err, io.EOF) {
+			b.logger.Println("Bot disconnected (EOF or disconnect). This usually means the account was logged in elsewhere or kicked.")
+		} else {
+			b.logger.Printf("Bot disconnected: %v", err)
+		}
+
+		time.Sleep(reconnectDelay)
+	}
+}
+
+// sleep waits for d or until a login retry is requested.
+func (b *Instance) sleep(d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-b.wake:
+	}
+}
+
+func (b *Instance) connect() (err error) {
+	b.setState(StateAuthenticating, nil)
+
+	client := bot.NewClient()
+	client.Authenticator = b.authenticator
+
+	// A client that didn't join is dropped, closing its bus stops the
+	// goroutines of its subscribers.
+	defer func() {
+		if err != nil {
+			_ = client.Close()
+		}
+	}()
+
+	client.Events.OnError = b.reportPacketError
+
+	bot.Subscribe(client.Bus, func(e bot.Kicked) {
+		b.logger.Printf("Kicked: %s", e.Reason.ClearString())
+	})
+
+	err = client.Authenticate()
+	if err != nil {
+		return err
+	}
+
+	name := client.Auth.Name
+
+	player, err := basic.NewPlayer(client, b.settings, basic.EventsListener{
+		Death: b.onDeath,
+	})
+	if err != nil {
+		return err
+	}
+
+	w, err := world.NewWorld(client, player)
+	if err != nil {
+		return err
+	}
+
+	tracker, err := entities.NewTracker(client, player, entities.EventsListener{
+		Radius:       b.config.HostileRadius,
+		HostileNear:  b.onHostileNear,
+		HostileLeave: b.onHostileLeave,
+	})
+	if err != nil {
+		return err
+	}
+
+	ph, err := physics.NewPhysics(client, player, w, tracker)
+	if err != nil {
+		return err
+	}
+	recvQueue, sendQueue := bot.NewReceiveQueue(b.config.ReceiveQueue), bot.NewSendQueue(b.config.SendQueue)
+
+	b.mu.Lock()
+	b.client, b.player, b.world, b.tracker, b.physics, b.name = client, player, w, tracker, ph, name
+	b.recvQueue, b.sendQueue = recvQueue, sendQueue
+	b.mu.Unlock()
+
+	b.waitUntilOffline(name)
+
+	b.setState(StateConnecting, nil)
+
+	err = client.JoinServerWithOptions(b.config.Address, bot.JoinOptions{
+		QueueRead:  recvQueue,
+		QueueWrite: sendQueue,
+		Capture:    b.tap,
+		Protocol:   b.protocolVersion(),
+	})
+	if err != nil {
+		return err
+	}
+
+	b.logger.Println("Joined server")
+	b.setState(StateOnline, nil)
+
+	return nil
+}
+
+// protocolVersion picks the version to speak: the configured one, else the
+// one the server reports, else the latest.
+func (b *Instance) protocolVersion() *protocol.Version {
+	// The config was validated, the pinned version is known.
+	if v, ok := protocol.Lookup(b.config.Protocol); ok {
+		return v
+	}
+
+	status, err := GetServerStatus(b.config.Address)
+	if err != nil {
+		b.logger.Printf("Failed to get server version, assuming %s: %v", protocol.Latest, err)
+
+		return protocol.Latest
+	}
+
+	v, ok := protocol.Lookup(status.Version.Protocol)
+	if !ok {
+		b.logger.Printf("Server runs unsupported %s (protocol %d), trying %s", status.Version.Name, status.Version.Protocol, protocol.Latest)
+
+		return protocol.Latest
+	}
+
+	return v
+}
+
+// waitUntilOffline blocks while the bot's account is listed as online on
+// the server, which usually means the real player is using it.
+func (b *Instance) waitUntilOffline(name string) {
+	for first := true; ; first = false {
+		if !first {
+			time.Sleep(onlineCheckBackoff)
+		}
+
+		online, err := isPlayerOnline(b.config.Address, name)
+		if err != nil {
+			b.setState(StateWaiting, err)
+			b.logger.Printf("failed to check if player is online: %v", err)
+			b.logger.Println("Bot will try again in a minute")
+
+			continue
+		}
+
+		if !online {
+			if !first {
+				b.logger.Println("Player is now offline, bot will join.")
+			}
+
+			return
+		}
+
+		if first {
+			b.setState(StateWaiting, nil)
+			b.logger.Println("Player is already online, bot will wait till player leaves.")
+		}
+	}
+}
+
+func (b *Instance) handleGame() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		err := b.physics.Run(ctx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			b.logger.Printf("Movement stopped: %v", err)
+		}
+	}()
+
+	// errors are the times of the packet errors skipped lately.
+	var errs []time.Time
+
+	for {
+		err := b.client.HandleGame()
+		if err == nil {
+			panic("HandleGame never return nil")
+		}
+
+		if herr := new(bot.PacketHandlerError); errors.As(err, herr) {
+			b.reportPacketError(*herr)
+
+			var action string
+			errs, action = packetErrorAction(b.config, errs, time.Now())
+
+			switch action {
+			case PacketErrorsReset:
+				b.resetState()
+
+				continue
+			case PacketErrorsSkip:
+				continue
+			}
+
+			err = fmt.Errorf("giving up on the session after %d packet errors: %w", len(errs), err)
+		}
+
+		_ = b.client.Close()
+
+		return err
+	}
+}
+
+// packetErrorAction counts a packet error at now into errs, the times of
+// the errors lately, and returns them with what the error does under the
+// bot's policy: PacketErrorsSkip, PacketErrorsReset or, after more than
+// MaxPacketErrors in packetErrorWindow, PacketErrorsReconnect.
+func packetErrorAction(config BotConfig, errs []time.Time, now time.Time) ([]time.Time, string) {
+	errs = slices.DeleteFunc(append(errs, now), func(t time.Time) bool {
+		return now.Sub(t) > packetErrorWindow
+	})
+
+	if len(errs) > config.MaxPacketErrors {
+		return errs, PacketErrorsReconnect
+	}
+
+	return errs, config.PacketErrors
+}
+
+// resetState drops what the bot knows about the world after a packet error
+// may have left it out of sync. It stands still until the server places it
+// again, and the server only sends the chunks again as the bot gets to new
+// ones or after a login or respawn.
+func (b *Instance) resetState() {
+	b.logger.Println("Dropped the world and entities, standing still until the server places the bot")
+
+	b.world.Reset()
+	b.tracker.Reset()
+	b.physics.Reset()
+}
+
+// reportPacketError logs a packet a handler failed on, with the stack if
+// the handler panicked.
+func (b *Instance) reportPacketError(err bot.PacketHandlerError) {
+	b.logger.Print(err)
+
+	if p := new(bot.PanicError); errors.As(err, p) {
+		b.logger.Printf("%s", p.Stack)
+	}
+}
+
+// onDeath records the death, respawns after five seconds and has the bot
+// return to the AFK spot it had before dying.
+func (b *Instance) onDeath(d basic.Death) error {
+	b.mu.Lock()
+	player, ph, tracker := b.player, b.physics, b.tracker
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if b.cancelRecovery != nil {
+		b.cancelRecovery()
+	}
+
+	b.cancelRecovery = cancel
+	b.mu.Unlock()
+
+	pos := ph.Position()
+	spot, hasSpot := ph.Anchor()
+
+	death := api.Death{
+		Time:      time.Now(),
+		Message:   d.Message.ClearString(),
+		Cause:     d.Cause,
+		Dimension: player.DimensionName,
+		X:         pos.X,
+		Y:         pos.Y,
+		Z:         pos.Z,
+	}
+
+	if killer, ok := tracker.Entity(d.KillerID); ok {
+		death.Killer = killer.TypeName
+		if killer.TypeName == "player" {
+			death.KillerUUID = killer.UUID.String()
+		}
+	}
+
+	b.mu.Lock()
+	b.deaths = append(b.deaths, death)
+	if len(b.deaths) > maxDeaths {
+		b.deaths = b.deaths[len(b.deaths)-maxDeaths:]
+	}
+	b.mu.Unlock()
+
+	err := appendDeath(b.config.DeathsFile, death)
+	if err != nil {
+		b.logger.Printf("Failed to save the death: %v", err)
+	}
+
+	b.logger.Printf("Died at %.1f %.1f %.1f: %s, respawning", pos.X, pos.Y, pos.Z, death.Message)
+
+	go func() {
+		time.Sleep(time.Second * 5)
+		err := player.Respawn()
+		if err != nil {
+			b.logger.Print(err)
+
+			return
+		}
+
+		if hasSpot {
+			b.returnToSpot(ctx, player, ph, spot)
+		}
+	}()
+
+	return nil
+}
+
+func (b *Instance) onHostileNear(e entities.Entity, distance float64) error {
+	b.logger.Printf("Hostile %s approaching, %.1f blocks away", e.TypeName, distance)
+
+	return nil
+}
+
+func (b *Instance) onHostileLeave(e entities.Entity) error {
+	b.logger.Printf("Hostile %s left", e.TypeName)
+
+	return nil
+}
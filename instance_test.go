@@ -0,0 +1,66 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPacketErrorAction(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		max    int
+		// at are the seconds the errors come at, want what each does.
+		at   []int
+		want []string
+	}{
+		{
+			name:   "skip up to the limit",
+			policy: PacketErrorsSkip,
+			max:    2,
+			at:     []int{0, 10, 20},
+			want:   []string{PacketErrorsSkip, PacketErrorsSkip, PacketErrorsReconnect},
+		},
+		{
+			name:   "old errors leave the window",
+			policy: PacketErrorsSkip,
+			max:    2,
+			at:     []int{0, 10, 61, 72},
+			want:   []string{PacketErrorsSkip, PacketErrorsSkip, PacketErrorsSkip, PacketErrorsSkip},
+		},
+		{
+			name:   "reset",
+			policy: PacketErrorsReset,
+			max:    1,
+			at:     []int{0, 61, 90},
+			want:   []string{PacketErrorsReset, PacketErrorsReset, PacketErrorsReconnect},
+		},
+		{
+			name:   "reconnect",
+			policy: PacketErrorsReconnect,
+			max:    10,
+			at:     []int{0},
+			want:   []string{PacketErrorsReconnect},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := BotConfig{PacketErrors: tt.policy, MaxPacketErrors: tt.max}
+			start := time.Now()
+
+			var errs []time.Time
+			var got []string
+			for _, s := range tt.at {
+				var action string
+				errs, action = packetErrorAction(config, errs, start.Add(time.Duration(s)*time.Second))
+				got = append(got, action)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("actions %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,38 +2,58 @@ package main
 
 import (
 	"log"
+	"maps"
+	"sync"
 	"time"
 )
 
-var LastSeen = make(map[string]time.Time)
+type LastSeenTracker struct {
+	address string
 
-func StartLastSeenPoller(address string) {
+	mu       sync.Mutex
+	lastSeen map[string]time.Time
+}
+
+func NewLastSeenTracker(address string) *LastSeenTracker {
+	return &LastSeenTracker{
+		address:  address,
+		lastSeen: make(map[string]time.Time),
+	}
+}
+
+func (t *LastSeenTracker) Start() {
 	go func() {
-		updateLastSeen(address)
+		t.update()
 
 		ticker := time.NewTicker(time.Minute)
 		defer ticker.Stop()
 
 		for range ticker.C {
-			updateLastSeen(address)
+			t.update()
 		}
 	}()
 }
 
-func updateLastSeen(address string) {
-	players, err := GetOnlinePlayers(address)
+func (t *LastSeenTracker) update() {
+	players, err := GetOnlinePlayers(t.address)
 	if err != nil {
-		log.Printf("lastseen poll: failed to get online players: %v", err)
+		log.Printf("lastseen poll %s: failed to get online players: %v", t.address, err)
 		return
 	}
 
 	now := time.Now()
 
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	for _, p := range players {
-		LastSeen[p] = now
+		t.lastSeen[p] = now
 	}
 }
 
-func GetLastSeen() map[string]time.Time {
-	return LastSeen
+func (t *LastSeenTracker) LastSeen() map[string]time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return maps.Clone(t.lastSeen)
 }
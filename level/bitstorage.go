@@ -0,0 +1,57 @@
+package level
+
+import (
+	"errors"
+	"strconv"
+)
+
+// BitStorage packs size values of bits bits each into longs. Since 1.16 a
+// value never spans two longs, the high bits of a long may be unused.
+type BitStorage struct {
+	data    []uint64
+	bits    int
+	mask    uint64
+	perLong int
+	size    int
+}
+
+// NewBitStorage wraps data, or allocates it if nil.
+func NewBitStorage(bits, size int, data []uint64) (*BitStorage, error) {
+	if bits <= 0 || bits > 32 {
+		return nil, errors.New("level: invalid bits per entry " + strconv.Itoa(bits))
+	}
+
+	b := &BitStorage{
+		bits:    bits,
+		mask:    1<<bits - 1,
+		perLong: 64 / bits,
+		size:    size,
+	}
+
+	length := (size + b.perLong - 1) / b.perLong
+	if data == nil {
+		data = make([]uint64, length)
+	} else if len(data) != length {
+		return nil, errors.New("level: " + strconv.Itoa(len(data)) + " longs for " +
+			strconv.Itoa(size) + " entries of " + strconv.Itoa(bits) + " bits, want " + strconv.Itoa(length))
+	}
+
+	b.data = data
+
+	return b, nil
+}
+
+func (b *BitStorage) Bits() int { return b.bits }
+
+func (b *BitStorage) Len() int { return b.size }
+
+func (b *BitStorage) Get(i int) int {
+	cell, offset := i/b.perLong, i%b.perLong*b.bits
+
+	return int(b.data[cell] >> offset & b.mask)
+}
+
+func (b *BitStorage) Set(i, v int) {
+	cell, offset := i/b.perLong, i%b.perLong*b.bits
+	b.data[cell] = b.data[cell]&^(b.mask<<offset) | (uint64(v)&b.mask)<<offset
+}
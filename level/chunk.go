@@ -0,0 +1,151 @@
+// Package level decodes the chunks of the world as sent by the server.
+package level
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+
+	"mcAfkGo/nbt"
+	pk "mcAfkGo/net/packet"
+)
+
+type ChunkPos struct{ X, Z int32 }
+
+// ChunkPosFromLong decodes a chunk position packed in a long, x in the low
+// 32 bits.
+func ChunkPosFromLong(v int64) ChunkPos {
+	return ChunkPos{X: int32(v), Z: int32(v >> 32)}
+}
+
+// SectionPos is the position of a 16x16x16 section.
+type SectionPos struct{ X, Y, Z int32 }
+
+// SectionPosFromLong decodes a section position packed in a long as 22
+// bits x, 22 bits z and 20 bits y.
+func SectionPosFromLong(v int64) SectionPos {
+	return SectionPos{
+		X: int32(v >> 42),
+		Y: int32(v << 44 >> 44),
+		Z: int32(v << 22 >> 42),
+	}
+}
+
+// Chunk is a 16 block wide column of sections, the lowest first.
+type Chunk struct {
+	Sections      []Section
+	HeightMaps    HeightMaps
+	BlockEntities []BlockEntity
+}
+
+type Section struct {
+	BlockCount int16
+	States     *PaletteContainer
+	Biomes     *PaletteContainer
+}
+
+// HeightMaps are the highest blocks of each column, 256 values packed by
+// BitStorage. They are absent if the server doesn't send them.
+type HeightMaps struct {
+	MotionBlocking []int64 `nbt:"MOTION_BLOCKING,omitempty"`
+	WorldSurface   []int64 `nbt:"WORLD_SURFACE,omitempty"`
+}
+
+type BlockEntity struct {
+	// XZ are the coordinates in the chunk, x in the high nibble.
+	XZ   uint8
+	Y    int16
+	Type int32
+	Data nbt.RawMessage
+}
+
+// ReadFrom reads the chunk data of a level chunk packet, from the
+// heightmaps to the block entities. The light data following it is left
+// unread.
+func (c *Chunk) ReadFrom(r io.Reader) (int64, error) {
+	var data pk.ByteArray
+	n, err := pk.Tuple{
+		pk.NBTField{V: &c.HeightMaps, AllowUnknownFields: true},
+		&data,
+	}.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+
+	c.Sections = c.Sections[:0]
+
+	rd := bytes.NewReader(data)
+	for rd.Len() > 0 {
+		var s Section
+		_, err = s.ReadFrom(rd)
+		if err != nil {
+			return n, errors.New("level: section " + strconv.Itoa(len(c.Sections)) + ": " + err.Error())
+		}
+
+		c.Sections = append(c.Sections, s)
+	}
+
+	var count pk.VarInt
+	n1, err := count.ReadFrom(r)
+	n += n1
+	if err != nil {
+		return n, err
+	}
+
+	if count < 0 || count > BlocksPerSection*64 {
+		return n, errors.New("level: invalid block entity count " + strconv.Itoa(int(count)))
+	}
+
+	c.BlockEntities = make([]BlockEntity, count)
+	for i := range c.BlockEntities {
+		e := &c.BlockEntities[i]
+		n1, err = pk.Tuple{
+			(*pk.UnsignedByte)(&e.XZ),
+			(*pk.Short)(&e.Y),
+			(*pk.VarInt)(&e.Type),
+			pk.NBTField{V: &e.Data, AllowUnknownFields: true},
+		}.ReadFrom(r)
+		n += n1
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+func (s *Section) ReadFrom(r io.Reader) (int64, error) {
+	n, err := (*pk.Short)(&s.BlockCount).ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+
+	var n1 int64
+	s.States, n1, err = readStatesContainer(r)
+	n += n1
+	if err != nil {
+		return n, err
+	}
+
+	s.Biomes, n1, err = readBiomesContainer(r)
+	n += n1
+
+	return n, err
+}
+
+// GetBlock returns the block state at x, y, z in the section, each 0-15.
+func (s *Section) GetBlock(x, y, z int) int32 {
+	return s.States.Get(y<<8 | z<<4 | x)
+}
+
+// SetBlock changes a block state. BlockCount is left as sent, telling air
+// from other blocks needs the block registry.
+func (s *Section) SetBlock(x, y, z int, state int32) {
+	s.States.Set(y<<8|z<<4|x, state)
+}
+
+// GetBiome returns the biome at x, y, z in the section, each 0-3.
+func (s *Section) GetBiome(x, y, z int) int32 {
+	return s.Biomes.Get(y<<4 | z<<2 | x)
+}
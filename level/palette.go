@@ -0,0 +1,204 @@
+package level
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+
+	pk "mcAfkGo/net/packet"
+)
+
+// Paletted container sizes and the widest indirect palettes, above which
+// the wire format stores global IDs directly.
+const (
+	BlocksPerSection = 16 * 16 * 16
+	BiomesPerSection = 4 * 4 * 4
+
+	maxBlocksIndirectBits = 8
+	maxBiomesIndirectBits = 3
+
+	// minBlocksIndirectBits is the width indirect block palettes are
+	// widened to.
+	minBlocksIndirectBits = 4
+)
+
+// PaletteContainer holds the block states or biomes of a section. A
+// single value container has no storage, an indirect one stores indices
+// into palette and a direct one global IDs, with palette nil.
+type PaletteContainer struct {
+	size    int
+	palette []int32
+	storage *BitStorage
+}
+
+// NewStatesContainer returns a section of a single block state.
+func NewStatesContainer(state int32) *PaletteContainer {
+	return &PaletteContainer{size: BlocksPerSection, palette: []int32{state}}
+}
+
+// NewBiomesContainer returns a section of a single biome.
+func NewBiomesContainer(biome int32) *PaletteContainer {
+	return &PaletteContainer{size: BiomesPerSection, palette: []int32{biome}}
+}
+
+func readStatesContainer(r io.Reader) (*PaletteContainer, int64, error) {
+	return readContainer(r, BlocksPerSection, minBlocksIndirectBits, maxBlocksIndirectBits)
+}
+
+func readBiomesContainer(r io.Reader) (*PaletteContainer, int64, error) {
+	return readContainer(r, BiomesPerSection, 1, maxBiomesIndirectBits)
+}
+
+func readContainer(r io.Reader, size, minIndirect, maxIndirect int) (*PaletteContainer, int64, error) {
+	c := &PaletteContainer{size: size}
+
+	var bits pk.UnsignedByte
+	n, err := bits.ReadFrom(r)
+	if err != nil {
+		return nil, n, err
+	}
+
+	switch {
+	case bits == 0:
+		var v pk.VarInt
+		n1, err := v.ReadFrom(r)
+		n += n1
+		if err != nil {
+			return nil, n, err
+		}
+
+		c.palette = []int32{int32(v)}
+
+	case int(bits) <= maxIndirect:
+		bits = pk.UnsignedByte(max(int(bits), minIndirect))
+
+		var length pk.VarInt
+		n1, err := length.ReadFrom(r)
+		n += n1
+		if err != nil {
+			return nil, n, err
+		}
+
+		if length <= 0 || int(length) > 1<<bits {
+			return nil, n, errors.New("level: invalid palette length " + strconv.Itoa(int(length)))
+		}
+
+		c.palette = make([]int32, length)
+		for i := range c.palette {
+			var v pk.VarInt
+			n1, err = v.ReadFrom(r)
+			n += n1
+			if err != nil {
+				return nil, n, err
+			}
+
+			c.palette[i] = int32(v)
+		}
+	}
+
+	var length pk.VarInt
+	n1, err := length.ReadFrom(r)
+	n += n1
+	if err != nil {
+		return nil, n, err
+	}
+
+	if length < 0 || int(length) > size {
+		return nil, n, errors.New("level: invalid data length " + strconv.Itoa(int(length)))
+	}
+
+	data := make([]uint64, length)
+	for i := range data {
+		var v pk.Long
+		n1, err = v.ReadFrom(r)
+		n += n1
+		if err != nil {
+			return nil, n, err
+		}
+
+		data[i] = uint64(v)
+	}
+
+	if bits == 0 {
+		// Servers send no data for single values, but skip it if they do.
+		return c, n, nil
+	}
+
+	c.storage, err = NewBitStorage(int(bits), size, data)
+	if err != nil {
+		return nil, n, err
+	}
+
+	return c, n, nil
+}
+
+// Get returns the value at index i, in YZX order.
+func (c *PaletteContainer) Get(i int) int32 {
+	if c.storage == nil {
+		return c.palette[0]
+	}
+
+	v := c.storage.Get(i)
+	if c.palette == nil {
+		return int32(v)
+	}
+
+	if v >= len(c.palette) {
+		return 0
+	}
+
+	return c.palette[v]
+}
+
+// Set changes the value at index i, widening the container if needed.
+func (c *PaletteContainer) Set(i int, v int32) {
+	if c.palette == nil {
+		if c.storage.Bits() < 32 && v >= 1<<c.storage.Bits() {
+			c.grow(bitsFor(int(v) + 1))
+		}
+
+		c.storage.Set(i, int(v))
+
+		return
+	}
+
+	idx := slices.Index(c.palette, v)
+	if c.storage == nil && idx == 0 {
+		return
+	}
+
+	if idx < 0 {
+		idx = len(c.palette)
+		c.palette = append(c.palette, v)
+
+		if c.storage == nil || idx >= 1<<c.storage.Bits() {
+			c.grow(bitsFor(len(c.palette)))
+		}
+	}
+
+	c.storage.Set(i, idx)
+}
+
+// grow moves the values to a wider storage. The palette is kept as is;
+// unlike on the wire it may get wider than maxIndirect.
+func (c *PaletteContainer) grow(bits int) {
+	storage, _ := NewBitStorage(bits, c.size, nil)
+	if c.storage != nil {
+		for i := 0; i < c.size; i++ {
+			storage.Set(i, c.storage.Get(i))
+		}
+	}
+
+	c.storage = storage
+}
+
+// bitsFor returns the bits needed to store n distinct values.
+func bitsFor(n int) int {
+	bits := 1
+	for 1<<bits < n {
+		bits++
+	}
+
+	return bits
+}
@@ -0,0 +1,133 @@
+package level
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pk "mcAfkGo/net/packet"
+)
+
+func TestPaletteContainerSet(t *testing.T) {
+	tests := []struct {
+		name string
+		// values are set at the indices 0, 1, ...
+		values   []int32
+		wantBits int
+		// wantDirect is whether the palette was dropped for global IDs.
+		wantDirect bool
+	}{
+		{name: "single value", values: []int32{1, 1, 1}},
+		{name: "indirect", values: []int32{1, 2, 3}, wantBits: 2},
+		{name: "widened", values: []int32{1, 2, 3, 4, 5, 6, 7, 8, 9}, wantBits: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewStatesContainer(1)
+			for i, v := range tt.values {
+				c.Set(i, v)
+			}
+
+			bits := 0
+			if c.storage != nil {
+				bits = c.storage.Bits()
+			}
+
+			if bits != tt.wantBits || (c.palette == nil) != tt.wantDirect {
+				t.Errorf("bits %d, direct %v, want %d, %v", bits, c.palette == nil, tt.wantBits, tt.wantDirect)
+			}
+
+			for i, v := range tt.values {
+				if got := c.Get(i); got != v {
+					t.Errorf("Get(%d) = %d, want %d", i, got, v)
+				}
+			}
+
+			if got := c.Get(BlocksPerSection - 1); got != 1 {
+				t.Errorf("Get(last) = %d, want 1", got)
+			}
+		})
+	}
+}
+
+func TestReadStatesContainer(t *testing.T) {
+	// longs packs values of bits bits from the low bits up, as many as fit
+	// in a long.
+	longs := func(bits int, values ...uint64) []pk.FieldEncoder {
+		perLong := 64 / bits
+		data := make([]uint64, (BlocksPerSection+perLong-1)/perLong)
+		for i, v := range values {
+			data[i/perLong] |= v << (i % perLong * bits)
+		}
+
+		fields := []pk.FieldEncoder{pk.VarInt(len(data))}
+		for _, l := range data {
+			fields = append(fields, pk.Long(l))
+		}
+
+		return fields
+	}
+
+	tests := []struct {
+		name    string
+		fields  []pk.FieldEncoder
+		want    []int32
+		wantErr string
+	}{
+		{
+			name:   "single value",
+			fields: []pk.FieldEncoder{pk.UnsignedByte(0), pk.VarInt(9), pk.VarInt(0)},
+			want:   []int32{9, 9},
+		},
+		{
+			// Palettes narrower than 4 bits are widened to 4.
+			name:   "indirect",
+			fields: append([]pk.FieldEncoder{pk.UnsignedByte(2), pk.VarInt(2), pk.VarInt(5), pk.VarInt(7)}, longs(4, 1, 0, 1)...),
+			want:   []int32{7, 5, 7, 5},
+		},
+		{
+			name:   "direct",
+			fields: append([]pk.FieldEncoder{pk.UnsignedByte(15)}, longs(15, 0, 27000, 3, 0, 1)...),
+			want:   []int32{0, 27000, 3, 0, 1},
+		},
+		{
+			name:    "empty palette",
+			fields:  []pk.FieldEncoder{pk.UnsignedByte(4), pk.VarInt(0)},
+			wantErr: "invalid palette length 0",
+		},
+		{
+			name:    "short data",
+			fields:  []pk.FieldEncoder{pk.UnsignedByte(4), pk.VarInt(1), pk.VarInt(5), pk.VarInt(1), pk.Long(0)},
+			wantErr: "1 longs for 4096 entries of 4 bits, want 256",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			for _, f := range tt.fields {
+				if _, err := f.WriteTo(&buf); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			c, _, err := readStatesContainer(&buf)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("read = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("read: %v", err)
+			}
+
+			for i, v := range tt.want {
+				if got := c.Get(i); got != v {
+					t.Errorf("Get(%d) = %d, want %d", i, got, v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"mcAfkGo/api"
+)
+
+// Manager owns every configured bot instance. Bots pointing at the same
+// server share a single last-seen poller.
+type Manager struct {
+	instances []*Instance
+	trackers  map[string]*LastSeenTracker
+}
+
+func NewManager(cfg *Config) (*Manager, error) {
+	m := &Manager{trackers: make(map[string]*LastSeenTracker)}
+
+	for _, bc := range cfg.Bots {
+		tracker, ok := m.trackers[bc.Address]
+		if !ok {
+			tracker = NewLastSeenTracker(bc.Address)
+			m.trackers[bc.Address] = tracker
+		}
+
+		instance, err := NewInstance(bc, tracker)
+		if err != nil {
+			return nil, err
+		}
+
+		m.instances = append(m.instances, instance)
+	}
+
+	return m, nil
+}
+
+func (m *Manager) Bots() []api.Bot {
+	bots := make([]api.Bot, len(m.instances))
+	for i, instance := range m.instances {
+		bots[i] = instance
+	}
+
+	return bots
+}
+
+// Start launches the last-seen pollers and a supervisor goroutine for every
+// bot instance.
+func (m *Manager) Start() {
+	for _, tracker := range m.trackers {
+		tracker.Start()
+	}
+
+	for _, instance := range m.instances {
+		go instance.Run()
+	}
+}
@@ -0,0 +1,89 @@
+package maths
+
+// AABB is an axis aligned box, as entities and blocks collide.
+type AABB struct{ Min, Max Vec3 }
+
+// BlockAABB returns the full cube of the block at x, y, z.
+func BlockAABB(x, y, z int) AABB {
+	v := Vec3{float64(x), float64(y), float64(z)}
+
+	return AABB{v, v.Add(Vec3{1, 1, 1})}
+}
+
+func (b AABB) Offset(v Vec3) AABB { return AABB{b.Min.Add(v), b.Max.Add(v)} }
+
+// Expand grows the box in the direction of v, covering where it moves.
+func (b AABB) Expand(v Vec3) AABB {
+	if v.X < 0 {
+		b.Min.X += v.X
+	} else {
+		b.Max.X += v.X
+	}
+
+	if v.Y < 0 {
+		b.Min.Y += v.Y
+	} else {
+		b.Max.Y += v.Y
+	}
+
+	if v.Z < 0 {
+		b.Min.Z += v.Z
+	} else {
+		b.Max.Z += v.Z
+	}
+
+	return b
+}
+
+func (b AABB) Intersects(o AABB) bool {
+	return b.Min.X < o.Max.X && b.Max.X > o.Min.X &&
+		b.Min.Y < o.Max.Y && b.Max.Y > o.Min.Y &&
+		b.Min.Z < o.Max.Z && b.Max.Z > o.Min.Z
+}
+
+// ClipX returns how far b can move by dx along x before hitting o.
+func (b AABB) ClipX(o AABB, dx float64) float64 {
+	if b.Max.Y <= o.Min.Y || b.Min.Y >= o.Max.Y || b.Max.Z <= o.Min.Z || b.Min.Z >= o.Max.Z {
+		return dx
+	}
+
+	return clip(b.Min.X, b.Max.X, o.Min.X, o.Max.X, dx)
+}
+
+// ClipY returns how far b can move by dy along y before hitting o.
+func (b AABB) ClipY(o AABB, dy float64) float64 {
+	if b.Max.X <= o.Min.X || b.Min.X >= o.Max.X || b.Max.Z <= o.Min.Z || b.Min.Z >= o.Max.Z {
+		return dy
+	}
+
+	return clip(b.Min.Y, b.Max.Y, o.Min.Y, o.Max.Y, dy)
+}
+
+// ClipZ returns how far b can move by dz along z before hitting o.
+func (b AABB) ClipZ(o AABB, dz float64) float64 {
+	if b.Max.X <= o.Min.X || b.Min.X >= o.Max.X || b.Max.Y <= o.Min.Y || b.Min.Y >= o.Max.Y {
+		return dz
+	}
+
+	return clip(b.Min.Z, b.Max.Z, o.Min.Z, o.Max.Z, dz)
+}
+
+// clip shortens d, the move of the span lo-hi, to stop at the span
+// oLo-oHi. Spans already overlapping don't block, like in the game; the
+// epsilon keeps rounding errors from letting a box sink into another.
+func clip(lo, hi, oLo, oHi, d float64) float64 {
+	const epsilon = 1e-7
+
+	switch {
+	case d > 0 && hi <= oLo+epsilon:
+		d = min(d, oLo-hi)
+	case d < 0 && lo >= oHi-epsilon:
+		d = max(d, oHi-lo)
+	}
+
+	if d > -epsilon && d < epsilon {
+		return 0
+	}
+
+	return d
+}
@@ -0,0 +1,21 @@
+// Package maths has the vector type positions and velocities are kept in.
+package maths
+
+import "math"
+
+type Vec3 struct{ X, Y, Z float64 }
+
+func (v Vec3) Add(o Vec3) Vec3 { return Vec3{v.X + o.X, v.Y + o.Y, v.Z + o.Z} }
+
+func (v Vec3) Sub(o Vec3) Vec3 { return Vec3{v.X - o.X, v.Y - o.Y, v.Z - o.Z} }
+
+func (v Vec3) Scale(f float64) Vec3 { return Vec3{v.X * f, v.Y * f, v.Z * f} }
+
+func (v Vec3) Len() float64 { return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z) }
+
+func (v Vec3) DistanceTo(o Vec3) float64 { return v.Sub(o).Len() }
+
+// Floor returns the block v is in.
+func (v Vec3) Floor() (x, y, z int) {
+	return int(math.Floor(v.X)), int(math.Floor(v.Y)), int(math.Floor(v.Z))
+}
@@ -48,7 +48,20 @@ func readVarIntConn(conn net.Conn) (int, error) {
 	return num, nil
 }
 
-func GetOnlinePlayers(address string) ([]string, error) {
+// ServerStatus is the part of a server list ping response the bot uses.
+type ServerStatus struct {
+	Version struct {
+		Name     string `json:"name"`
+		Protocol int32  `json:"protocol"`
+	} `json:"version"`
+	Players struct {
+		Sample []struct {
+			Name string `json:"name"`
+		} `json:"sample"`
+	} `json:"players"`
+}
+
+func GetServerStatus(address string) (*ServerStatus, error) {
 	host, portStr, err := net.SplitHostPort(address)
 	if err != nil {
 		return nil, err
@@ -112,15 +125,17 @@ func GetOnlinePlayers(address string) ([]string, error) {
 		read += n
 	}
 
-	var status struct {
-		Players struct {
-			Sample []struct {
-				Name string `json:"name"`
-			} `json:"sample"`
-		} `json:"players"`
+	var status ServerStatus
+	err = json.Unmarshal(data, &status)
+	if err != nil {
+		return nil, err
 	}
 
-	err = json.Unmarshal(data, &status)
+	return &status, nil
+}
+
+func GetOnlinePlayers(address string) ([]string, error) {
+	status, err := GetServerStatus(address)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,167 @@
+// Package capture records the packets of a connection to a file and reads
+// them back for offline debugging.
+//
+// A capture file starts with a short header followed by one record per
+// packet: a flags byte holding the state and direction, the time as a
+// VarLong of Unix microseconds, the packet ID as a VarInt and the raw,
+// uncompressed payload as a length-prefixed byte array.
+package capture
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	pk "mcAfkGo/net/packet"
+)
+
+const magic = "MCCAP\x01"
+
+type State byte
+
+const (
+	StateHandshake State = iota
+	StateLogin
+	StateConfiguration
+	StatePlay
+)
+
+func (s State) String() string {
+	switch s {
+	case StateHandshake:
+		return "handshake"
+	case StateLogin:
+		return "login"
+	case StateConfiguration:
+		return "configuration"
+	case StatePlay:
+		return "play"
+	default:
+		return fmt.Sprintf("State(%d)", byte(s))
+	}
+}
+
+type Direction byte
+
+const (
+	Inbound Direction = iota
+	Outbound
+)
+
+func (d Direction) String() string {
+	if d == Outbound {
+		return "outbound"
+	}
+
+	return "inbound"
+}
+
+type Record struct {
+	Time      time.Time
+	State     State
+	Direction Direction
+	Packet    pk.Packet
+}
+
+func (r Record) WriteTo(w io.Writer) (int64, error) {
+	flags := pk.UnsignedByte(r.State)<<1 | pk.UnsignedByte(r.Direction&1)
+
+	return pk.Tuple{
+		flags,
+		pk.VarLong(r.Time.UnixMicro()),
+		pk.VarInt(r.Packet.ID),
+		pk.ByteArray(r.Packet.Data),
+	}.WriteTo(w)
+}
+
+func (r *Record) ReadFrom(rd io.Reader) (int64, error) {
+	var (
+		flags pk.UnsignedByte
+		micro pk.VarLong
+		id    pk.VarInt
+		data  pk.ByteArray
+	)
+
+	n, err := flags.ReadFrom(rd)
+	if err != nil {
+		return 0, err
+	}
+
+	n1, err := pk.Tuple{&micro, &id, &data}.ReadFrom(rd)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+
+		return n + n1, err
+	}
+
+	r.State = State(flags >> 1)
+	r.Direction = Direction(flags & 1)
+	r.Time = time.UnixMicro(int64(micro))
+	r.Packet = pk.Packet{ID: int32(id), Data: data}
+
+	return n + n1, nil
+}
+
+// Reader reads the records of a capture file in order.
+type Reader struct {
+	r *bufio.Reader
+}
+
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(magic))
+
+	_, err := io.ReadFull(br, header)
+	if err != nil {
+		return nil, fmt.Errorf("read capture header: %w", err)
+	}
+
+	if !bytes.Equal(header, []byte(magic)) {
+		return nil, errors.New("not a capture file")
+	}
+
+	return &Reader{r: br}, nil
+}
+
+// Next returns the next record, or io.EOF after the last one.
+func (r *Reader) Next() (Record, error) {
+	var rec Record
+	_, err := rec.ReadFrom(r.r)
+
+	return rec, err
+}
+
+// ReadFile returns all records of the capture file at path.
+func ReadFile(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	r, err := NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+
+		if err != nil {
+			return records, fmt.Errorf("record %d: %w", len(records), err)
+		}
+
+		records = append(records, rec)
+	}
+}
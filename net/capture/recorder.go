@@ -0,0 +1,187 @@
+package capture
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	pk "mcAfkGo/net/packet"
+)
+
+// Recorder appends the packets of a connection to a capture file. It
+// implements net.Tap, so it can be attached to a net.Conn directly; the
+// owner of the connection keeps it informed about the protocol state.
+//
+// When the file would grow beyond MaxBytes it is rotated to Path.1, the
+// previous Path.1 to Path.2 and so on, keeping at most MaxFiles old files.
+type Recorder struct {
+	Path     string
+	MaxBytes int64
+	MaxFiles int
+
+	mu    sync.Mutex
+	state State
+	file  *os.File
+	size  int64
+	buf   bytes.Buffer
+	err   error
+}
+
+// NewRecorder starts a new capture file at path. An existing file is
+// rotated away first. maxBytes <= 0 disables rotation.
+func NewRecorder(path string, maxBytes int64, maxFiles int) (*Recorder, error) {
+	r := &Recorder{Path: path, MaxBytes: maxBytes, MaxFiles: maxFiles}
+
+	info, err := os.Stat(path)
+	if err == nil && info.Size() > 0 {
+		err = r.rotate()
+	} else if errors.Is(err, fs.ErrNotExist) {
+		err = r.open()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// SetState sets the state the following packets are recorded with.
+func (r *Recorder) SetState(state State) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state = state
+}
+
+// TapPacket records p in the current state. Recording stops after the
+// first write error, which Err returns.
+func (r *Recorder) TapPacket(outbound bool, p pk.Packet) {
+	dir := Inbound
+	if outbound {
+		dir = Outbound
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.write(Record{Time: time.Now(), State: r.state, Direction: dir, Packet: p})
+}
+
+func (r *Recorder) Record(rec Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.write(rec)
+
+	return r.err
+}
+
+func (r *Recorder) write(rec Record) {
+	if r.err != nil {
+		return
+	}
+
+	r.buf.Reset()
+	_, _ = rec.WriteTo(&r.buf)
+
+	if r.MaxBytes > 0 && r.size > int64(len(magic)) && r.size+int64(r.buf.Len()) > r.MaxBytes {
+		err := r.rotate()
+		if err != nil {
+			r.fail(err)
+
+			return
+		}
+	}
+
+	n, err := r.file.Write(r.buf.Bytes())
+	r.size += int64(n)
+	if err != nil {
+		r.fail(err)
+	}
+}
+
+func (r *Recorder) fail(err error) {
+	r.err = err
+	log.Printf("capture %s: recording stopped: %v", r.Path, err)
+}
+
+// Err returns the error that stopped the recording, if any.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Close()
+	r.file = nil
+	if r.err == nil {
+		r.err = os.ErrClosed
+	}
+
+	return err
+}
+
+func (r *Recorder) open() error {
+	f, err := os.OpenFile(r.Path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.WriteString(magic)
+	if err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	r.file, r.size = f, int64(len(magic))
+
+	return nil
+}
+
+func (r *Recorder) rotate() error {
+	if r.file != nil {
+		err := r.file.Close()
+		r.file = nil
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.MaxFiles <= 0 {
+		return r.open()
+	}
+
+	for i := r.MaxFiles - 1; i >= 1; i-- {
+		err := os.Rename(r.rotated(i), r.rotated(i+1))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	err := os.Rename(r.Path, r.rotated(1))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return r.open()
+}
+
+func (r *Recorder) rotated(i int) string {
+	return fmt.Sprintf("%s.%d", r.Path, i)
+}
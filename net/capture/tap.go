@@ -0,0 +1,33 @@
+package capture
+
+import pk "mcAfkGo/net/packet"
+
+// Tap is a net.Tap that is also told about protocol state changes, which
+// the connection itself doesn't know about.
+type Tap interface {
+	TapPacket(outbound bool, p pk.Packet)
+	SetState(state State)
+}
+
+// MultiTap returns a Tap passing everything on to all of taps.
+func MultiTap(taps ...Tap) Tap {
+	if len(taps) == 1 {
+		return taps[0]
+	}
+
+	return multiTap(taps)
+}
+
+type multiTap []Tap
+
+func (m multiTap) TapPacket(outbound bool, p pk.Packet) {
+	for _, t := range m {
+		t.TapPacket(outbound, p)
+	}
+}
+
+func (m multiTap) SetState(state State) {
+	for _, t := range m {
+		t.SetState(state)
+	}
+}
@@ -2,6 +2,7 @@
 package net
 
 import (
+	"bytes"
 	"context"
 	"crypto/cipher"
 	"errors"
@@ -17,14 +18,62 @@ const DefaultPort = 25565
 
 type Listener struct{ net.Listener }
 
+func ListenMC(addr string) (*Listener, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Listener{l}, nil
+}
+
+func (l Listener) Accept() (*Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return WrapConn(conn), nil
+}
+
 type Conn struct {
 	Socket net.Conn
 	io.Reader
 	io.Writer
 
+	// Tap, if set, sees every packet read or written, e.g. to record it.
+	Tap Tap
+
+	// IDs, if set, translates packet IDs between the wire and the ones the
+	// caller and Tap deal with.
+	IDs IDMapper
+
 	threshold int
 }
 
+// Tap observes the packets passing a Conn. p is only valid during the call.
+type Tap interface {
+	TapPacket(outbound bool, p pk.Packet)
+}
+
+// IDMapper translates packet IDs for a protocol version that numbers its
+// packets differently.
+type IDMapper interface {
+	// Inbound returns the ID of a received packet, or -1 if unknown.
+	Inbound(id int32) int32
+	// Outbound returns the wire ID of a packet to send, false if the
+	// version doesn't have it.
+	Outbound(id int32) (int32, bool)
+}
+
+// Rewriter is implemented by IDMappers of versions that lay out some
+// packets differently from the ones the caller deals with.
+type Rewriter interface {
+	// RewriteInbound rewrites the data of a received packet, its ID
+	// translated already.
+	RewriteInbound(p *pk.Packet)
+}
+
 var DefaultDialer = Dialer{}
 
 type MCDialer interface {
@@ -171,11 +220,69 @@ func WrapConn(conn net.Conn) *Conn {
 func (c *Conn) Close() error { return c.Socket.Close() }
 
 func (c *Conn) ReadPacket(p *pk.Packet) error {
-	return p.UnPack(c.Reader, c.threshold)
+	err := p.UnPack(c.Reader, c.threshold)
+	if err != nil {
+		return err
+	}
+
+	if c.IDs != nil {
+		p.ID = c.IDs.Inbound(p.ID)
+		if rw, ok := c.IDs.(Rewriter); ok {
+			rw.RewriteInbound(p)
+		}
+	}
+
+	if c.Tap != nil {
+		c.Tap.TapPacket(false, *p)
+	}
+
+	return nil
 }
 
 func (c *Conn) WritePacket(p pk.Packet) error {
-	return p.Pack(c.Writer, c.threshold)
+	return c.WritePackets(p)
+}
+
+// WritePackets writes packets with a single write to the socket.
+func (c *Conn) WritePackets(packets ...pk.Packet) error {
+	var buf bytes.Buffer
+	for _, p := range packets {
+		id, err := c.OutboundID(p.ID)
+		if err != nil {
+			return err
+		}
+
+		p.ID = id
+
+		err = p.Pack(&buf, c.threshold)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := c.Writer.Write(buf.Bytes())
+	if err == nil && c.Tap != nil {
+		for _, p := range packets {
+			c.Tap.TapPacket(true, p)
+		}
+	}
+
+	return err
+}
+
+// OutboundID returns the wire ID of a packet to send, or an error if the
+// protocol version doesn't have it.
+func (c *Conn) OutboundID(id int32) (int32, error) {
+	if c.IDs == nil {
+		return id, nil
+	}
+
+	wire, ok := c.IDs.Outbound(id)
+	if !ok {
+		return 0, errors.New("packet 0x" + strconv.FormatInt(int64(id), 16) + " not supported by the protocol version")
+	}
+
+	return wire, nil
 }
 
 func (c *Conn) SetCipher(ecoStream, decoStream cipher.Stream) {
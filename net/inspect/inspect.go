@@ -0,0 +1,181 @@
+// Package inspect turns raw packets into readable trees of named fields,
+// driven by a declarative schema of the packets the bot deals with.
+package inspect
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/net/capture"
+	pk "mcAfkGo/net/packet"
+)
+
+// Schema describes the fields of one packet.
+type Schema struct {
+	Name   string
+	Fields []Spec
+}
+
+type schemaKey struct {
+	state     capture.State
+	direction capture.Direction
+	id        int32
+}
+
+// Registry maps packets to their schema. Packet IDs are only unique within
+// a state and direction, so those are part of the key.
+type Registry struct {
+	schemas map[schemaKey]Schema
+}
+
+func NewRegistry() *Registry {
+	return &Registry{schemas: make(map[schemaKey]Schema)}
+}
+
+// Clientbound registers the schema of a packet sent by the server.
+func (r *Registry) Clientbound(state capture.State, id packetid.ClientboundPacketID, name string, fields ...Spec) {
+	r.schemas[schemaKey{state, capture.Inbound, int32(id)}] = Schema{Name: name, Fields: fields}
+}
+
+// Serverbound registers the schema of a packet sent by the client.
+func (r *Registry) Serverbound(state capture.State, id packetid.ServerboundPacketID, name string, fields ...Spec) {
+	r.schemas[schemaKey{state, capture.Outbound, int32(id)}] = Schema{Name: name, Fields: fields}
+}
+
+func (r *Registry) Lookup(state capture.State, direction capture.Direction, id int32) (Schema, bool) {
+	s, ok := r.schemas[schemaKey{state, direction, id}]
+
+	return s, ok
+}
+
+// Missing lists the play packets in direction that have no schema, by the
+// name Decode gives them.
+func (r *Registry) Missing(direction capture.Direction) []string {
+	count := int32(packetid.ClientboundPacketIDGuard)
+	if direction == capture.Outbound {
+		count = int32(packetid.ServerboundPacketIDGuard)
+	}
+
+	var missing []string
+	for id := range count {
+		if _, ok := r.Lookup(capture.StatePlay, direction, id); !ok {
+			missing = append(missing, unknownName(capture.StatePlay, direction, id))
+		}
+	}
+
+	return missing
+}
+
+// Packet is a decoded packet. Fields holds what the schema could decode,
+// Remaining the bytes it didn't cover and Error why decoding stopped.
+// NoSchema is set for packets the registry has no schema of, which keep
+// their whole payload in Remaining.
+type Packet struct {
+	Time      time.Time `json:"time,omitzero"`
+	State     string    `json:"state"`
+	Direction string    `json:"direction"`
+	ID        int32     `json:"id"`
+	Name      string    `json:"name"`
+	NoSchema  bool      `json:"no_schema,omitempty"`
+	Fields    Fields    `json:"fields,omitempty"`
+	Remaining string    `json:"remaining,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	Size      int       `json:"size"`
+}
+
+// Decode decodes p as sent in state in the given direction. Packets
+// without a schema are flagged NoSchema and keep their payload as hex in
+// Remaining.
+func (r *Registry) Decode(state capture.State, direction capture.Direction, p pk.Packet) Packet {
+	out := Packet{
+		State:     state.String(),
+		Direction: direction.String(),
+		ID:        p.ID,
+		Size:      len(p.Data),
+	}
+
+	schema, ok := r.Lookup(state, direction, p.ID)
+	if !ok {
+		out.Name = unknownName(state, direction, p.ID)
+		out.NoSchema = true
+		out.Remaining = hex.EncodeToString(p.Data)
+
+		return out
+	}
+
+	out.Name = schema.Name
+
+	rd := bytes.NewReader(p.Data)
+	fields, err := decodeFields(rd, schema.Fields)
+	out.Fields = fields
+
+	if err != nil {
+		out.Error = err.Error()
+	}
+
+	if rd.Len() > 0 {
+		out.Remaining = hex.EncodeToString(p.Data[len(p.Data)-rd.Len():])
+	}
+
+	return out
+}
+
+// unknownName names a packet without a schema. Play packets are named
+// after their packetid constant, as in "boss_event".
+func unknownName(state capture.State, direction capture.Direction, id int32) string {
+	var name string
+	switch {
+	case state != capture.StatePlay:
+	case direction == capture.Inbound && id < int32(packetid.ClientboundPacketIDGuard):
+		name = strings.TrimPrefix(packetid.ClientboundPacketID(id).String(), "Clientbound")
+	case direction == capture.Outbound && id < int32(packetid.ServerboundPacketIDGuard):
+		name = strings.TrimPrefix(packetid.ServerboundPacketID(id).String(), "Serverbound")
+	}
+
+	if name == "" || id < 0 {
+		return fmt.Sprintf("unknown 0x%02x", id)
+	}
+
+	var b strings.Builder
+	for i, c := range name {
+		if unicode.IsUpper(c) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+
+			c = unicode.ToLower(c)
+		}
+
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+// DecodeRecord decodes a captured packet.
+func (r *Registry) DecodeRecord(rec capture.Record) Packet {
+	out := r.Decode(rec.State, rec.Direction, rec.Packet)
+	out.Time = rec.Time
+
+	return out
+}
+
+// Default knows the packets of the protocol version the bot speaks.
+var Default = NewRegistry()
+
+func Missing(direction capture.Direction) []string {
+	return Default.Missing(direction)
+}
+
+func Decode(state capture.State, direction capture.Direction, p pk.Packet) Packet {
+	return Default.Decode(state, direction, p)
+}
+
+func DecodeRecord(rec capture.Record) Packet {
+	return Default.DecodeRecord(rec)
+}
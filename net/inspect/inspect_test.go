@@ -0,0 +1,105 @@
+package inspect
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/chat"
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/net/capture"
+	"mcAfkGo/net/metadata"
+	pk "mcAfkGo/net/packet"
+)
+
+func TestDecodePlay(t *testing.T) {
+	sender := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := []struct {
+		name string
+		p    pk.Packet
+		// want is a part of the packet's JSON.
+		want string
+	}{
+		{
+			name: "player chat",
+			p: pk.Marshal(packetid.ClientboundPlayerChat,
+				pk.UUID(sender), pk.VarInt(0), pk.Boolean(false),
+				pk.String("hello"), pk.Long(1), pk.Long(2),
+				pk.VarInt(2), pk.VarInt(5), pk.VarInt(0), pk.PluginMessageData(make([]byte, 256)),
+				pk.Boolean(false),
+				pk.VarInt(2), pk.VarInt(1), pk.Long(4),
+				pk.VarInt(1), chat.Text("Steve"), pk.Boolean(false),
+			),
+			want: `"message":"hello","timestamp":1,"salt":2,"previous_messages":[{"index":4},{"signature":"0000`,
+		},
+		{
+			name: "disguised chat",
+			p:    pk.Marshal(packetid.ClientboundDisguisedChat, chat.Text("hi"), pk.VarInt(1), chat.Text("Server"), pk.Boolean(false)),
+			want: `"message":"hi","chat_type":1,"sender_name":"Server","target_name":null`,
+		},
+		{
+			name: "player info update",
+			p: pk.Marshal(packetid.ClientboundPlayerInfoUpdate,
+				pk.Byte(0b01001), pk.VarInt(1),
+				pk.UUID(sender), pk.String("Steve"), pk.VarInt(0), pk.Boolean(true),
+			),
+			want: `"actions":["add_player","listed"],"players":[{"uuid":"00000000-0000-0000-0000-000000000001","add_player":{"name":"Steve","properties":[]},"listed":true}]`,
+		},
+		{
+			name: "set entity data",
+			p: pk.Marshal(packetid.ClientboundSetEntityData,
+				pk.VarInt(7),
+				pk.UnsignedByte(0), pk.VarInt(metadata.TypeByte), pk.Byte(2),
+				pk.UnsignedByte(2), pk.VarInt(metadata.TypeOptionalComponent), pk.Boolean(false),
+				pk.UnsignedByte(0xff),
+			),
+			want: `"entity_id":7,"data":{"0":{"type":0,"value":2},"2":{"type":6,"value":{"Has":false`,
+		},
+		{
+			name: "explode",
+			p: pk.Marshal(packetid.ClientboundExplode,
+				pk.Double(1), pk.Double(2), pk.Double(3), pk.Float(4),
+				pk.VarInt(1), pk.Byte(-1), pk.Byte(0), pk.Byte(1),
+				pk.Float(0.5), pk.Float(0), pk.Float(0),
+				pk.VarInt(1),
+				pk.VarInt(0), pk.VarInt(0),
+				pk.VarInt(0), pk.Identifier("minecraft:entity.generic.explode"), pk.Boolean(false),
+			),
+			want: `"blocks":[{"dx":-1,"dy":0,"dz":1}],"knockback_x":0.5`,
+		},
+		{
+			name: "section blocks update",
+			p: pk.Marshal(packetid.ClientboundSectionBlocksUpdate,
+				pk.Long(-1<<42|3<<20|(-4)&0xfffff),
+				pk.VarInt(1), pk.VarLong(9<<12|1<<8|2<<4|3),
+			),
+			want: `"section":{"x":-1,"y":-4,"z":3},"blocks":[{"state":9,"x":1,"y":3,"z":2}]`,
+		},
+		{
+			name: "damage event",
+			p:    pk.Marshal(packetid.ClientboundDamageEvent, pk.VarInt(7), pk.VarInt(1), pk.VarInt(0), pk.VarInt(0), pk.Boolean(false)),
+			want: `"entity_id":7,"source_type":1,"source_cause_id":0,"source_direct_id":0,"source_position":null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := Decode(capture.StatePlay, capture.Inbound, tt.p)
+			if out.NoSchema || out.Error != "" || out.Remaining != "" {
+				t.Fatalf("decoded %s: no schema %v, error %q, remaining %q", out.Name, out.NoSchema, out.Error, out.Remaining)
+			}
+
+			b, err := json.Marshal(out.Fields)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(string(b), tt.want) {
+				t.Errorf("fields = %s, want %s", b, tt.want)
+			}
+		})
+	}
+}
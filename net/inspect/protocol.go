@@ -0,0 +1,511 @@
+package inspect
+
+import (
+	"bytes"
+
+	"mcAfkGo/data/packetid"
+	"mcAfkGo/net/capture"
+	pk "mcAfkGo/net/packet"
+)
+
+// Schemas of protocol 767 (1.21). Handshake, login and configuration are
+// complete, so are the play packets the bot's handlers read. Play packets
+// the bot doesn't care about are left out, Missing lists them and Decode
+// flags them NoSchema.
+func init() {
+	registerHandshake(Default)
+	registerLogin(Default)
+	registerConfiguration(Default)
+	registerPlay(Default)
+}
+
+var (
+	property = Struct(
+		F("name", String),
+		F("value", String),
+		F("signature", Optional(String)),
+	)
+	knownPack = Struct(
+		F("namespace", String),
+		F("id", String),
+		F("version", String),
+	)
+	clientInformation = []Spec{
+		F("locale", String),
+		F("view_distance", Byte),
+		F("chat_mode", VarInt),
+		F("chat_colors", Bool),
+		F("skin_parts", UByte),
+		F("main_hand", VarInt),
+		F("text_filtering", Bool),
+		F("allow_server_listings", Bool),
+	}
+	tags = Array(Struct(
+		F("registry", Identifier),
+		F("tags", Array(Struct(
+			F("name", Identifier),
+			F("entries", Array(VarInt)),
+		))),
+	))
+	// chatType is how a chat message is shown, by the chat_type registry
+	// ID plus one.
+	chatType = []Spec{
+		F("chat_type", VarInt),
+		F("sender_name", Chat),
+		F("target_name", Optional(Chat)),
+	}
+	resourcePackPush = []Spec{
+		F("uuid", UUID),
+		F("url", String),
+		F("hash", String),
+		F("forced", Bool),
+		F("prompt", Optional(Chat)),
+	}
+)
+
+func registerHandshake(r *Registry) {
+	// The handshake is the only packet of its state and has no ID
+	// constant in packetid.
+	r.Serverbound(capture.StateHandshake, 0, "intention",
+		F("protocol_version", VarInt),
+		F("host", String),
+		F("port", UShort),
+		F("next_state", VarInt),
+	)
+}
+
+func registerLogin(r *Registry) {
+	const s = capture.StateLogin
+
+	r.Clientbound(s, packetid.ClientboundLoginLoginDisconnect, "login_disconnect", F("reason", JSONChat))
+	r.Clientbound(s, packetid.ClientboundLoginHello, "hello",
+		F("server_id", String),
+		F("public_key", ByteArray),
+		F("verify_token", ByteArray),
+		F("should_authenticate", Bool),
+	)
+	r.Clientbound(s, packetid.ClientboundLoginGameProfile, "game_profile",
+		F("uuid", UUID),
+		F("name", String),
+		F("properties", Array(property)),
+		F("strict_error_handling", Bool),
+	)
+	r.Clientbound(s, packetid.ClientboundLoginLoginCompression, "login_compression", F("threshold", VarInt))
+	r.Clientbound(s, packetid.ClientboundLoginCustomQuery, "custom_query",
+		F("message_id", VarInt),
+		F("channel", Identifier),
+		F("data", Rest),
+	)
+	r.Clientbound(s, packetid.ClientboundLoginCookieRequest, "cookie_request", F("key", Identifier))
+
+	r.Serverbound(s, packetid.ServerboundLoginHello, "hello", F("name", String), F("uuid", UUID))
+	r.Serverbound(s, packetid.ServerboundLoginKey, "key",
+		F("shared_secret", ByteArray),
+		F("verify_token", ByteArray),
+	)
+	r.Serverbound(s, packetid.ServerboundLoginCustomQueryAnswer, "custom_query_answer",
+		F("message_id", VarInt),
+		F("data", Optional(Rest)),
+	)
+	r.Serverbound(s, packetid.ServerboundLoginLoginAcknowledged, "login_acknowledged")
+	r.Serverbound(s, packetid.ServerboundLoginCookieResponse, "cookie_response",
+		F("key", Identifier),
+		F("payload", Optional(ByteArray)),
+	)
+}
+
+func registerConfiguration(r *Registry) {
+	const s = capture.StateConfiguration
+
+	r.Clientbound(s, packetid.ClientboundConfigCookieRequest, "cookie_request", F("key", Identifier))
+	r.Clientbound(s, packetid.ClientboundConfigCustomPayload, "custom_payload", F("channel", Identifier), F("data", Rest))
+	r.Clientbound(s, packetid.ClientboundConfigDisconnect, "disconnect", F("reason", Chat))
+	r.Clientbound(s, packetid.ClientboundConfigFinishConfiguration, "finish_configuration")
+	r.Clientbound(s, packetid.ClientboundConfigKeepAlive, "keep_alive", F("id", Long))
+	r.Clientbound(s, packetid.ClientboundConfigPing, "ping", F("id", Int))
+	r.Clientbound(s, packetid.ClientboundConfigResetChat, "reset_chat")
+	r.Clientbound(s, packetid.ClientboundConfigRegistryData, "registry_data",
+		F("registry", Identifier),
+		F("entries", Array(Struct(
+			F("id", Identifier),
+			F("data", Optional(NBT)),
+		))),
+	)
+	r.Clientbound(s, packetid.ClientboundConfigResourcePackPop, "resource_pack_pop", F("uuid", Optional(UUID)))
+	r.Clientbound(s, packetid.ClientboundConfigResourcePackPush, "resource_pack_push", resourcePackPush...)
+	r.Clientbound(s, packetid.ClientboundConfigStoreCookie, "store_cookie", F("key", Identifier), F("payload", ByteArray))
+	r.Clientbound(s, packetid.ClientboundConfigTransfer, "transfer", F("host", String), F("port", VarInt))
+	r.Clientbound(s, packetid.ClientboundConfigUpdateEnabledFeatures, "update_enabled_features", F("features", Array(Identifier)))
+	r.Clientbound(s, packetid.ClientboundConfigUpdateTags, "update_tags", F("registries", tags))
+	r.Clientbound(s, packetid.ClientboundConfigSelectKnownPacks, "select_known_packs", F("packs", Array(knownPack)))
+	r.Clientbound(s, packetid.ClientboundConfigCustomReportDetails, "custom_report_details",
+		F("details", Array(Struct(
+			F("title", String),
+			F("description", String),
+		))),
+	)
+	r.Clientbound(s, packetid.ClientboundConfigServerLinks, "server_links", F("links", Rest))
+
+	r.Serverbound(s, packetid.ServerboundConfigClientInformation, "client_information", clientInformation...)
+	r.Serverbound(s, packetid.ServerboundConfigCookieResponse, "cookie_response",
+		F("key", Identifier),
+		F("payload", Optional(ByteArray)),
+	)
+	r.Serverbound(s, packetid.ServerboundConfigCustomPayload, "custom_payload", F("channel", Identifier), F("data", Rest))
+	r.Serverbound(s, packetid.ServerboundConfigFinishConfiguration, "finish_configuration")
+	r.Serverbound(s, packetid.ServerboundConfigKeepAlive, "keep_alive", F("id", Long))
+	r.Serverbound(s, packetid.ServerboundConfigPong, "pong", F("id", Int))
+	r.Serverbound(s, packetid.ServerboundConfigResourcePack, "resource_pack", F("uuid", UUID), F("result", VarInt))
+	r.Serverbound(s, packetid.ServerboundConfigSelectKnownPacks, "select_known_packs", F("packs", Array(knownPack)))
+}
+
+func registerPlay(r *Registry) {
+	const s = capture.StatePlay
+
+	r.Clientbound(s, packetid.BundleDelimiter, "bundle_delimiter")
+	r.Clientbound(s, packetid.ClientboundAddEntity, "add_entity",
+		F("entity_id", VarInt),
+		F("uuid", UUID),
+		F("type", VarInt),
+		F("x", Double),
+		F("y", Double),
+		F("z", Double),
+		F("pitch", Angle),
+		F("yaw", Angle),
+		F("head_yaw", Angle),
+		F("data", VarInt),
+		F("velocity_x", Short),
+		F("velocity_y", Short),
+		F("velocity_z", Short),
+	)
+	r.Clientbound(s, packetid.ClientboundBlockEntityData, "block_entity_data",
+		F("location", Position),
+		F("type", VarInt),
+		F("data", NBT),
+	)
+	r.Clientbound(s, packetid.ClientboundBlockUpdate, "block_update", F("location", Position), F("block_state", VarInt))
+	r.Clientbound(s, packetid.ClientboundChangeDifficulty, "change_difficulty", F("difficulty", UByte), F("locked", Bool))
+	r.Clientbound(s, packetid.ClientboundChunkBatchFinished, "chunk_batch_finished", F("batch_size", VarInt))
+	r.Clientbound(s, packetid.ClientboundChunkBatchStart, "chunk_batch_start")
+	r.Clientbound(s, packetid.ClientboundCookieRequest, "cookie_request", F("key", Identifier))
+	r.Clientbound(s, packetid.ClientboundCustomPayload, "custom_payload", F("channel", Identifier), F("data", Rest))
+	r.Clientbound(s, packetid.ClientboundDamageEvent, "damage_event",
+		F("entity_id", VarInt),
+		F("source_type", VarInt),
+		F("source_cause_id", VarInt),
+		F("source_direct_id", VarInt),
+		F("source_position", Optional(Struct(
+			F("x", Double),
+			F("y", Double),
+			F("z", Double),
+		))),
+	)
+	r.Clientbound(s, packetid.ClientboundDisconnect, "disconnect", F("reason", Chat))
+	r.Clientbound(s, packetid.ClientboundDisguisedChat, "disguised_chat", append([]Spec{F("message", Chat)}, chatType...)...)
+	r.Clientbound(s, packetid.ClientboundExplode, "explode",
+		F("x", Double),
+		F("y", Double),
+		F("z", Double),
+		F("power", Float),
+		F("blocks", Array(Struct(
+			F("dx", Byte),
+			F("dy", Byte),
+			F("dz", Byte),
+		))),
+		F("knockback_x", Float),
+		F("knockback_y", Float),
+		F("knockback_z", Float),
+		F("block_interaction", VarInt),
+		F("small_particle", Particle),
+		F("large_particle", Particle),
+		F("sound", sound),
+	)
+	r.Clientbound(s, packetid.ClientboundForgetLevelChunk, "forget_level_chunk", F("z", Int), F("x", Int))
+	r.Clientbound(s, packetid.ClientboundGameEvent, "game_event", F("event", UByte), F("value", Float))
+	r.Clientbound(s, packetid.ClientboundKeepAlive, "keep_alive", F("id", Long))
+	r.Clientbound(s, packetid.ClientboundLevelChunkWithLight, "level_chunk_with_light",
+		F("x", Int),
+		F("z", Int),
+		F("heightmaps", NBT),
+		F("data", ByteArray),
+		F("rest", Rest),
+	)
+	r.Clientbound(s, packetid.ClientboundLogin, "login",
+		F("entity_id", Int),
+		F("hardcore", Bool),
+		F("dimensions", Array(Identifier)),
+		F("max_players", VarInt),
+		F("view_distance", VarInt),
+		F("simulation_distance", VarInt),
+		F("reduced_debug_info", Bool),
+		F("enable_respawn_screen", Bool),
+		F("limited_crafting", Bool),
+		F("dimension_type", VarInt),
+		F("dimension_name", Identifier),
+		F("hashed_seed", Long),
+		F("game_mode", UByte),
+		F("previous_game_mode", Byte),
+		F("is_debug", Bool),
+		F("is_flat", Bool),
+		F("death_location", Optional(Struct(
+			F("dimension", Identifier),
+			F("location", Position),
+		))),
+		F("portal_cooldown", VarInt),
+		F("enforces_secure_chat", Bool),
+	)
+	r.Clientbound(s, packetid.ClientboundMoveEntityPos, "move_entity_pos",
+		F("entity_id", VarInt),
+		F("dx", Short),
+		F("dy", Short),
+		F("dz", Short),
+		F("on_ground", Bool),
+	)
+	r.Clientbound(s, packetid.ClientboundMoveEntityPosRot, "move_entity_pos_rot",
+		F("entity_id", VarInt),
+		F("dx", Short),
+		F("dy", Short),
+		F("dz", Short),
+		F("yaw", Angle),
+		F("pitch", Angle),
+		F("on_ground", Bool),
+	)
+	r.Clientbound(s, packetid.ClientboundMoveEntityRot, "move_entity_rot",
+		F("entity_id", VarInt),
+		F("yaw", Angle),
+		F("pitch", Angle),
+		F("on_ground", Bool),
+	)
+	r.Clientbound(s, packetid.ClientboundPing, "ping", F("id", Int))
+	r.Clientbound(s, packetid.ClientboundPlayerChat, "player_chat", append([]Spec{
+		F("sender", UUID),
+		F("index", VarInt),
+		F("signature", Optional(Fixed(signatureLen))),
+		F("message", String),
+		F("timestamp", Long),
+		F("salt", Long),
+		F("previous_messages", Array(previousMessage)),
+		F("unsigned_content", Optional(Chat)),
+		F("filter", filterMask),
+	}, chatType...)...)
+	r.Clientbound(s, packetid.ClientboundPlayerCombatKill, "player_combat_kill", F("player_id", VarInt), F("message", Chat))
+	r.Clientbound(s, packetid.ClientboundPlayerInfoRemove, "player_info_remove", F("uuids", Array(UUID)))
+	r.Clientbound(s, packetid.ClientboundPlayerInfoUpdate, "player_info_update", F("update", playerInfoUpdate))
+	r.Clientbound(s, packetid.ClientboundPlayerPosition, "player_position",
+		F("x", Double),
+		F("y", Double),
+		F("z", Double),
+		F("yaw", Float),
+		F("pitch", Float),
+		F("flags", Byte),
+		F("teleport_id", VarInt),
+	)
+	r.Clientbound(s, packetid.ClientboundRemoveEntities, "remove_entities", F("entity_ids", Array(VarInt)))
+	r.Clientbound(s, packetid.ClientboundResourcePackPop, "resource_pack_pop", F("uuid", Optional(UUID)))
+	r.Clientbound(s, packetid.ClientboundResourcePackPush, "resource_pack_push", resourcePackPush...)
+	r.Clientbound(s, packetid.ClientboundRespawn, "respawn",
+		F("dimension_type", VarInt),
+		F("dimension_name", Identifier),
+		F("hashed_seed", Long),
+		F("game_mode", UByte),
+		F("previous_game_mode", Byte),
+		F("is_debug", Bool),
+		F("is_flat", Bool),
+		F("death_location", Optional(Struct(
+			F("dimension", Identifier),
+			F("location", Position),
+		))),
+		F("portal_cooldown", VarInt),
+		F("data_kept", Byte),
+	)
+	r.Clientbound(s, packetid.ClientboundRotateHead, "rotate_head", F("entity_id", VarInt), F("head_yaw", Angle))
+	r.Clientbound(s, packetid.ClientboundSectionBlocksUpdate, "section_blocks_update",
+		F("section", sectionPos),
+		F("blocks", Array(sectionBlock)),
+	)
+	r.Clientbound(s, packetid.ClientboundSetChunkCacheCenter, "set_chunk_cache_center", F("x", VarInt), F("z", VarInt))
+	r.Clientbound(s, packetid.ClientboundSetChunkCacheRadius, "set_chunk_cache_radius", F("radius", VarInt))
+	r.Clientbound(s, packetid.ClientboundSetDefaultSpawnPosition, "set_default_spawn_position", F("location", Position), F("angle", Float))
+	r.Clientbound(s, packetid.ClientboundSetEntityMotion, "set_entity_motion",
+		F("entity_id", VarInt),
+		F("velocity_x", Short),
+		F("velocity_y", Short),
+		F("velocity_z", Short),
+	)
+	r.Clientbound(s, packetid.ClientboundSetEntityData, "set_entity_data", F("entity_id", VarInt), F("data", EntityData))
+	r.Clientbound(s, packetid.ClientboundSetExperience, "set_experience",
+		F("progress", Float),
+		F("level", VarInt),
+		F("total", VarInt),
+	)
+	r.Clientbound(s, packetid.ClientboundSetHealth, "set_health",
+		F("health", Float),
+		F("food", VarInt),
+		F("saturation", Float),
+	)
+	r.Clientbound(s, packetid.ClientboundSetTime, "set_time", F("world_age", Long), F("time_of_day", Long))
+	r.Clientbound(s, packetid.ClientboundStartConfiguration, "start_configuration")
+	r.Clientbound(s, packetid.ClientboundStoreCookie, "store_cookie", F("key", Identifier), F("payload", ByteArray))
+	r.Clientbound(s, packetid.ClientboundSystemChat, "system_chat", F("content", Chat), F("overlay", Bool))
+	r.Clientbound(s, packetid.ClientboundTeleportEntity, "teleport_entity",
+		F("entity_id", VarInt),
+		F("x", Double),
+		F("y", Double),
+		F("z", Double),
+		F("yaw", Angle),
+		F("pitch", Angle),
+		F("on_ground", Bool),
+	)
+	r.Clientbound(s, packetid.ClientboundTransfer, "transfer", F("host", String), F("port", VarInt))
+	r.Clientbound(s, packetid.ClientboundUpdateTags, "update_tags", F("registries", tags))
+
+	r.Serverbound(s, packetid.ServerboundAcceptTeleportation, "accept_teleportation", F("teleport_id", VarInt))
+	r.Serverbound(s, packetid.ServerboundChatCommand, "chat_command", F("command", String))
+	r.Serverbound(s, packetid.ServerboundChunkBatchReceived, "chunk_batch_received", F("chunks_per_tick", Float))
+	r.Serverbound(s, packetid.ServerboundClientCommand, "client_command", F("action", VarInt))
+	r.Serverbound(s, packetid.ServerboundClientInformation, "client_information", clientInformation...)
+	r.Serverbound(s, packetid.ServerboundConfigurationAcknowledged, "configuration_acknowledged")
+	r.Serverbound(s, packetid.ServerboundCookieResponse, "cookie_response",
+		F("key", Identifier),
+		F("payload", Optional(ByteArray)),
+	)
+	r.Serverbound(s, packetid.ServerboundCustomPayload, "custom_payload", F("channel", Identifier), F("data", Rest))
+	r.Serverbound(s, packetid.ServerboundKeepAlive, "keep_alive", F("id", Long))
+	r.Serverbound(s, packetid.ServerboundMovePlayerPos, "move_player_pos",
+		F("x", Double),
+		F("y", Double),
+		F("z", Double),
+		F("on_ground", Bool),
+	)
+	r.Serverbound(s, packetid.ServerboundMovePlayerPosRot, "move_player_pos_rot",
+		F("x", Double),
+		F("y", Double),
+		F("z", Double),
+		F("yaw", Float),
+		F("pitch", Float),
+		F("on_ground", Bool),
+	)
+	r.Serverbound(s, packetid.ServerboundMovePlayerRot, "move_player_rot",
+		F("yaw", Float),
+		F("pitch", Float),
+		F("on_ground", Bool),
+	)
+	r.Serverbound(s, packetid.ServerboundMovePlayerStatusOnly, "move_player_status_only", F("on_ground", Bool))
+	r.Serverbound(s, packetid.ServerboundPong, "pong", F("id", Int))
+	r.Serverbound(s, packetid.ServerboundResourcePack, "resource_pack", F("uuid", UUID), F("result", VarInt))
+	r.Serverbound(s, packetid.ServerboundSwing, "swing", F("hand", VarInt))
+}
+
+// signatureLen is the length of a chat message signature.
+const signatureLen = 256
+
+// filterPartial is the filter type of a message with some of its words
+// filtered, followed by a bit set of them.
+const filterPartial = 2
+
+// previousMessage refers to a message seen before by its index plus one,
+// or gives its signature after a 0.
+var previousMessage = decodeFunc(func(r *bytes.Reader) (any, error) {
+	var id pk.VarInt
+
+	_, err := id.ReadFrom(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if id != 0 {
+		return Fields{{"index", int32(id) - 1}}, nil
+	}
+
+	signature, err := Fixed(signatureLen).Decode(r)
+
+	return Fields{{"signature", signature}}, err
+})
+
+var filterMask = decodeFunc(func(r *bytes.Reader) (any, error) {
+	var typ pk.VarInt
+
+	_, err := typ.ReadFrom(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if typ != filterPartial {
+		return Fields{{"type", int32(typ)}}, nil
+	}
+
+	mask, err := Array(Long).Decode(r)
+
+	return Fields{{"type", int32(typ)}, {"mask", mask}}, err
+})
+
+// sound is a sound event by its registry ID plus one, or inline after a 0.
+var sound = decodeFunc(func(r *bytes.Reader) (any, error) {
+	var id pk.VarInt
+
+	_, err := id.ReadFrom(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if id != 0 {
+		return Fields{{"id", int32(id) - 1}}, nil
+	}
+
+	return decodeFields(r, []Spec{F("name", Identifier), F("fixed_range", Optional(Float))})
+})
+
+// sectionPos is a chunk section packed into a long: 22 bits of x and z
+// and 20 of y.
+var sectionPos = value(func(v pk.Long) any {
+	return Fields{{"x", int64(v) >> 42}, {"y", int64(v) << 44 >> 44}, {"z", int64(v) << 22 >> 42}}
+})
+
+// sectionBlock is a block state followed by 12 bits of x, z and y within
+// the section.
+var sectionBlock = value(func(v pk.VarLong) any {
+	return Fields{{"state", int64(v) >> 12}, {"x", int64(v) >> 8 & 15}, {"y", int64(v) & 15}, {"z", int64(v) >> 4 & 15}}
+})
+
+// The actions of the player info update packet, in the order their data
+// follows for every player.
+var playerInfoActions = []Spec{
+	F("add_player", Struct(F("name", String), F("properties", Array(property)))),
+	F("initialize_chat", Optional(Struct(
+		F("session_id", UUID),
+		F("expires_at", Long),
+		F("public_key", ByteArray),
+		F("key_signature", ByteArray),
+	))),
+	F("game_mode", VarInt),
+	F("listed", Bool),
+	F("latency", VarInt),
+	F("display_name", Optional(Chat)),
+}
+
+// playerInfoUpdate is a set of actions and the players with the data of
+// each of them.
+var playerInfoUpdate = decodeFunc(func(r *bytes.Reader) (any, error) {
+	var actions pk.Byte
+
+	_, err := actions.ReadFrom(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs []Spec
+	for i, spec := range playerInfoActions {
+		if actions&(1<<i) != 0 {
+			specs = append(specs, spec)
+		}
+	}
+
+	names := make([]any, 0, len(specs))
+	for _, spec := range specs {
+		names = append(names, spec.Name)
+	}
+
+	players, err := Array(Struct(append([]Spec{F("uuid", UUID)}, specs...)...)).Decode(r)
+
+	return Fields{{"actions", names}, {"players", players}}, err
+})
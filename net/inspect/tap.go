@@ -0,0 +1,120 @@
+package inspect
+
+import (
+	"encoding/json"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"mcAfkGo/net/capture"
+	pk "mcAfkGo/net/packet"
+)
+
+// Logger is a capture.Tap logging every packet decoded as one JSON line.
+type Logger struct {
+	Registry *Registry
+	Log      *log.Logger
+
+	mu    sync.Mutex
+	state capture.State
+}
+
+func NewLogger(l *log.Logger) *Logger {
+	return &Logger{Registry: Default, Log: l}
+}
+
+func (l *Logger) SetState(state capture.State) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.state = state
+}
+
+func (l *Logger) TapPacket(outbound bool, p pk.Packet) {
+	l.mu.Lock()
+	state := l.state
+	l.mu.Unlock()
+
+	decoded := l.Registry.Decode(state, direction(outbound), p)
+
+	b, err := json.Marshal(decoded)
+	if err != nil {
+		l.Log.Printf("packet %s %s: %v", decoded.Direction, decoded.Name, err)
+
+		return
+	}
+
+	l.Log.Printf("packet %s", b)
+}
+
+// Ring is a capture.Tap keeping the last packets of a connection in
+// memory. They are only decoded when asked for.
+type Ring struct {
+	Registry *Registry
+
+	mu      sync.Mutex
+	state   capture.State
+	records []capture.Record
+	next    int
+	full    bool
+}
+
+func NewRing(size int) *Ring {
+	return &Ring{Registry: Default, records: make([]capture.Record, size)}
+}
+
+func (r *Ring) SetState(state capture.State) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state = state
+}
+
+func (r *Ring) TapPacket(outbound bool, p pk.Packet) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.records) == 0 {
+		return
+	}
+
+	// The packet's buffer is reused once the tap returns.
+	p.Data = slices.Clone(p.Data)
+
+	r.records[r.next] = capture.Record{Time: time.Now(), State: r.state, Direction: direction(outbound), Packet: p}
+	r.next = (r.next + 1) % len(r.records)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Packets returns up to the last n packets decoded, oldest first. n <= 0
+// returns all that are kept.
+func (r *Ring) Packets(n int) []Packet {
+	r.mu.Lock()
+	records := slices.Clone(r.records[:r.next])
+	if r.full {
+		records = append(slices.Clone(r.records[r.next:]), records...)
+	}
+	r.mu.Unlock()
+
+	if n > 0 && n < len(records) {
+		records = records[len(records)-n:]
+	}
+
+	packets := make([]Packet, len(records))
+	for i, rec := range records {
+		packets[i] = r.Registry.DecodeRecord(rec)
+	}
+
+	return packets
+}
+
+func direction(outbound bool) capture.Direction {
+	if outbound {
+		return capture.Outbound
+	}
+
+	return capture.Inbound
+}
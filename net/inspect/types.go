@@ -0,0 +1,346 @@
+package inspect
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/chat"
+	"mcAfkGo/data/registryid"
+	"mcAfkGo/nbt"
+	"mcAfkGo/net/metadata"
+	pk "mcAfkGo/net/packet"
+)
+
+// Type decodes one field of a packet into a JSON friendly value.
+type Type interface {
+	Decode(r *bytes.Reader) (any, error)
+}
+
+// Field is a decoded value with its name. A list of them keeps the order
+// of the packet when encoded as a JSON object.
+type Field struct {
+	Name  string
+	Value any
+}
+
+type Fields []Field
+
+func (f Fields) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, field := range f {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// Spec declares one field of a packet schema.
+type Spec struct {
+	Name string
+	Type Type
+}
+
+func F(name string, t Type) Spec {
+	return Spec{Name: name, Type: t}
+}
+
+// fieldType decodes a value with its pk type and converts the result.
+type fieldType[T any, P interface {
+	*T
+	pk.FieldDecoder
+}] func(T) any
+
+func value[T any, P interface {
+	*T
+	pk.FieldDecoder
+}](conv func(T) any) Type {
+	return fieldType[T, P](conv)
+}
+
+func (f fieldType[T, P]) Decode(r *bytes.Reader) (any, error) {
+	var v T
+
+	_, err := P(&v).ReadFrom(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return f(v), nil
+}
+
+var (
+	Bool       Type = value(func(v pk.Boolean) any { return bool(v) })
+	Byte       Type = value(func(v pk.Byte) any { return int8(v) })
+	UByte      Type = value(func(v pk.UnsignedByte) any { return uint8(v) })
+	Short      Type = value(func(v pk.Short) any { return int16(v) })
+	UShort     Type = value(func(v pk.UnsignedShort) any { return uint16(v) })
+	Int        Type = value(func(v pk.Int) any { return int32(v) })
+	Long       Type = value(func(v pk.Long) any { return int64(v) })
+	VarInt     Type = value(func(v pk.VarInt) any { return int32(v) })
+	VarLong    Type = value(func(v pk.VarLong) any { return int64(v) })
+	Float      Type = value(func(v pk.Float) any { return float32(v) })
+	Double     Type = value(func(v pk.Double) any { return float64(v) })
+	String     Type = value(func(v pk.String) any { return string(v) })
+	Identifier Type = value(func(v pk.Identifier) any { return string(v) })
+	UUID       Type = value(func(v pk.UUID) any { return uuid.UUID(v).String() })
+	ByteArray  Type = value(func(v pk.ByteArray) any { return hex.EncodeToString(v) })
+
+	// Angle is a rotation in 1/256 of a full turn, shown in degrees.
+	Angle Type = value(func(v pk.Angle) any { return float32(uint8(v)) * 360 / 256 })
+
+	Position Type = value(func(v pk.Position) any {
+		return Fields{{"x", v.X}, {"y", v.Y}, {"z", v.Z}}
+	})
+
+	// Chat is a text component sent as NBT, JSONChat one sent as JSON.
+	Chat     Type = value(func(v chat.Message) any { return v.ClearString() })
+	JSONChat Type = value(func(v chat.JsonMessage) any { return chat.Message(v).ClearString() })
+
+	// NBT is shown in SNBT.
+	NBT Type = nbtType{}
+
+	// Rest takes the remaining bytes of the packet.
+	Rest Type = restType{}
+)
+
+type nbtType struct{}
+
+func (nbtType) Decode(r *bytes.Reader) (any, error) {
+	var raw nbt.RawMessage
+
+	_, err := pk.NBTField{V: &raw, AllowUnknownFields: true}.ReadFrom(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return raw.String(), nil
+}
+
+type restType struct{}
+
+func (restType) Decode(r *bytes.Reader) (any, error) {
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.EncodeToString(rest), nil
+}
+
+// Optional is a value prefixed with a boolean telling whether it is
+// present. Missing values decode to nil.
+func Optional(t Type) Type { return optionalType{t} }
+
+type optionalType struct{ Type }
+
+func (o optionalType) Decode(r *bytes.Reader) (any, error) {
+	var has pk.Boolean
+
+	_, err := has.ReadFrom(r)
+	if err != nil || !has {
+		return nil, err
+	}
+
+	return o.Type.Decode(r)
+}
+
+// maxArrayLength guards against garbage lengths in damaged packets.
+const maxArrayLength = 1 << 16
+
+// Array is a VarInt length followed by that many values.
+func Array(t Type) Type { return arrayType{t} }
+
+type arrayType struct{ Type }
+
+func (a arrayType) Decode(r *bytes.Reader) (any, error) {
+	var length pk.VarInt
+
+	_, err := length.ReadFrom(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if length < 0 || length > maxArrayLength {
+		return nil, errors.New("invalid array length " + strconv.Itoa(int(length)))
+	}
+
+	values := make([]any, 0, length)
+	for i := 0; i < int(length); i++ {
+		v, err := a.Type.Decode(r)
+		if err != nil {
+			return values, err
+		}
+
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+// Struct groups several fields into one value.
+func Struct(specs ...Spec) Type { return structType(specs) }
+
+type structType []Spec
+
+func (s structType) Decode(r *bytes.Reader) (any, error) {
+	fields, err := decodeFields(r, s)
+
+	return fields, err
+}
+
+func decodeFields(r *bytes.Reader, specs []Spec) (Fields, error) {
+	fields := make(Fields, 0, len(specs))
+	for _, spec := range specs {
+		v, err := spec.Type.Decode(r)
+		if err != nil {
+			return fields, &FieldError{Field: spec.Name, Err: err}
+		}
+
+		fields = append(fields, Field{Name: spec.Name, Value: v})
+	}
+
+	return fields, nil
+}
+
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return "field " + e.Field + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Fixed is a byte string of n bytes without a length, shown in hex.
+func Fixed(n int) Type { return fixedType(n) }
+
+type fixedType int
+
+func (f fixedType) Decode(r *bytes.Reader) (any, error) {
+	b := make([]byte, f)
+
+	_, err := io.ReadFull(r, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// decodeFunc is a Type for a layout the other types can't describe, such
+// as fields present depending on an earlier one.
+type decodeFunc func(r *bytes.Reader) (any, error)
+
+func (f decodeFunc) Decode(r *bytes.Reader) (any, error) {
+	return f(r)
+}
+
+var (
+	// Slot is an item stack, its data components named after their type.
+	Slot Type = value(slotValue)
+	// Particle is a particle type with its options, kept in hex.
+	Particle Type = value(particleValue)
+
+	// EntityData is a list of entity data values by index, each with its
+	// type.
+	EntityData Type = value(func(v metadata.Metadata) any {
+		fields := make(Fields, 0, len(v))
+		for _, index := range slices.Sorted(maps.Keys(v)) {
+			fields = append(fields, Field{strconv.Itoa(int(index)), entityValue(v[index])})
+		}
+
+		return fields
+	})
+)
+
+func slotValue(v pk.Slot) any {
+	if v.Count <= 0 {
+		return nil
+	}
+
+	components := make(Fields, 0, len(v.Components))
+	for _, c := range v.Components {
+		components = append(components, Field{registryName(registryid.DataComponentType, c.Type), hex.EncodeToString(c.Data)})
+	}
+
+	removed := make([]string, 0, len(v.Removed))
+	for _, typ := range v.Removed {
+		removed = append(removed, registryName(registryid.DataComponentType, typ))
+	}
+
+	return Fields{{"count", v.Count}, {"item", v.ItemID}, {"components", components}, {"removed", removed}}
+}
+
+func particleValue(v pk.Particle) any {
+	return Fields{{"type", registryName(registryid.ParticleType, v.Type)}, {"options", hex.EncodeToString(v.Options)}}
+}
+
+// registryName returns the name of a registry entry, or its ID if the
+// table doesn't have it.
+func registryName(entries []string, id int32) string {
+	if id < 0 || int(id) >= len(entries) {
+		return strconv.Itoa(int(id))
+	}
+
+	return entries[id]
+}
+
+// entityValue turns an entity data value into its type and a JSON
+// friendly value.
+func entityValue(v metadata.Value) Fields {
+	var shown any
+
+	switch x := v.V.(type) {
+	case chat.Message:
+		shown = x.ClearString()
+	case nbt.RawMessage:
+		shown = x.String()
+	case pk.Slot:
+		shown = slotValue(x)
+	case pk.Particle:
+		shown = particleValue(x)
+	case []pk.Particle:
+		particles := make([]any, 0, len(x))
+		for _, p := range x {
+			particles = append(particles, particleValue(p))
+		}
+
+		shown = particles
+	default:
+		shown = x
+	}
+
+	return Fields{{"type", int32(v.Type)}, {"value", shown}}
+}
@@ -0,0 +1,397 @@
+// Package metadata decodes and encodes the entity data sent in set entity
+// data and add entity packets: a list of indexed values, each tagged with
+// its type.
+package metadata
+
+import (
+	"errors"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"mcAfkGo/chat"
+	"mcAfkGo/nbt"
+	pk "mcAfkGo/net/packet"
+)
+
+// Type is a serializer ID of protocol 767, the type of a value.
+type Type int32
+
+const (
+	TypeByte Type = iota
+	TypeVarInt
+	TypeVarLong
+	TypeFloat
+	TypeString
+	TypeComponent
+	TypeOptionalComponent
+	TypeSlot
+	TypeBoolean
+	TypeRotations
+	TypePosition
+	TypeOptionalPosition
+	TypeDirection
+	TypeOptionalUUID
+	TypeBlockState
+	TypeOptionalBlockState
+	TypeNBT
+	TypeParticle
+	TypeParticles
+	TypeVillagerData
+	TypeOptionalVarInt
+	TypePose
+	TypeCatVariant
+	TypeWolfVariant
+	TypeFrogVariant
+	TypeOptionalGlobalPos
+	TypePaintingVariant
+	TypeSnifferState
+	TypeArmadilloState
+	TypeVector3
+	TypeQuaternion
+)
+
+// end marks the end of the list in place of an index.
+const end = 0xff
+
+// Value is a decoded value. V holds, by type:
+//
+//   - Byte: pk.Byte
+//   - VarInt, Direction, BlockState, OptionalBlockState (0 for none), Pose
+//     and the variants and states: pk.VarInt
+//   - VarLong: pk.VarLong
+//   - Float: pk.Float
+//   - String: pk.String
+//   - Component: chat.Message
+//   - OptionalComponent: pk.Option[chat.Message, *chat.Message]
+//   - Slot: pk.Slot
+//   - Boolean: pk.Boolean
+//   - Rotations, Vector3: Vector3
+//   - Position: pk.Position
+//   - OptionalPosition: pk.Option[pk.Position, *pk.Position]
+//   - OptionalUUID: pk.Option[pk.UUID, *pk.UUID]
+//   - NBT: nbt.RawMessage
+//   - Particle: pk.Particle
+//   - Particles: []pk.Particle
+//   - VillagerData: VillagerData
+//   - OptionalVarInt: OptionalVarInt
+//   - OptionalGlobalPos: pk.Option[GlobalPos, *GlobalPos]
+//   - PaintingVariant: PaintingVariant
+//   - Quaternion: Quaternion
+type Value struct {
+	Type Type
+	V    any
+}
+
+// Metadata are the values of an entity by index. Packets carry only the
+// values that changed, reading one merges them.
+type Metadata map[uint8]Value
+
+func (m *Metadata) ReadFrom(r io.Reader) (int64, error) {
+	if *m == nil {
+		*m = make(Metadata)
+	}
+
+	var n int64
+	for {
+		var index pk.UnsignedByte
+		n1, err := index.ReadFrom(r)
+		n += n1
+		if err != nil {
+			return n, err
+		}
+
+		if index == end {
+			return n, nil
+		}
+
+		var v Value
+		n1, err = v.ReadFrom(r)
+		n += n1
+		if err != nil {
+			return n, errors.New("metadata: index " + strconv.Itoa(int(index)) + ": " + err.Error())
+		}
+
+		(*m)[uint8(index)] = v
+	}
+}
+
+// WriteTo writes the values in index order.
+func (m Metadata) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	for _, index := range slices.Sorted(maps.Keys(m)) {
+		n1, err := pk.Tuple{pk.UnsignedByte(index), m[index]}.WriteTo(w)
+		n += n1
+		if err != nil {
+			return n, err
+		}
+	}
+
+	n1, err := pk.UnsignedByte(end).WriteTo(w)
+
+	return n + n1, err
+}
+
+func (v *Value) ReadFrom(r io.Reader) (int64, error) {
+	n, err := (*pk.VarInt)(&v.Type).ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+
+	c, err := codecOf(v.Type)
+	if err != nil {
+		return n, err
+	}
+
+	var n1 int64
+	v.V, n1, err = c.read(r)
+
+	return n + n1, err
+}
+
+func (v Value) WriteTo(w io.Writer) (int64, error) {
+	c, err := codecOf(v.Type)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := pk.VarInt(v.Type).WriteTo(w)
+	if err != nil {
+		return n, err
+	}
+
+	n1, err := c.write(w, v.V)
+
+	return n + n1, err
+}
+
+// Get returns the value at index as T, false if there is none or it has
+// another type.
+func Get[T any](m Metadata, index uint8) (T, bool) {
+	v, ok := m[index].V.(T)
+
+	return v, ok
+}
+
+type Vector3 struct{ X, Y, Z float32 }
+
+func (v Vector3) WriteTo(w io.Writer) (int64, error) {
+	return pk.Tuple{pk.Float(v.X), pk.Float(v.Y), pk.Float(v.Z)}.WriteTo(w)
+}
+
+func (v *Vector3) ReadFrom(r io.Reader) (int64, error) {
+	return pk.Tuple{(*pk.Float)(&v.X), (*pk.Float)(&v.Y), (*pk.Float)(&v.Z)}.ReadFrom(r)
+}
+
+type Quaternion struct{ X, Y, Z, W float32 }
+
+func (q Quaternion) WriteTo(w io.Writer) (int64, error) {
+	return pk.Tuple{pk.Float(q.X), pk.Float(q.Y), pk.Float(q.Z), pk.Float(q.W)}.WriteTo(w)
+}
+
+func (q *Quaternion) ReadFrom(r io.Reader) (int64, error) {
+	return pk.Tuple{(*pk.Float)(&q.X), (*pk.Float)(&q.Y), (*pk.Float)(&q.Z), (*pk.Float)(&q.W)}.ReadFrom(r)
+}
+
+type VillagerData struct {
+	Type       int32
+	Profession int32
+	Level      int32
+}
+
+func (d VillagerData) WriteTo(w io.Writer) (int64, error) {
+	return pk.Tuple{pk.VarInt(d.Type), pk.VarInt(d.Profession), pk.VarInt(d.Level)}.WriteTo(w)
+}
+
+func (d *VillagerData) ReadFrom(r io.Reader) (int64, error) {
+	return pk.Tuple{(*pk.VarInt)(&d.Type), (*pk.VarInt)(&d.Profession), (*pk.VarInt)(&d.Level)}.ReadFrom(r)
+}
+
+// OptionalVarInt is sent as the value plus one, 0 for none.
+type OptionalVarInt struct {
+	Has bool
+	Val int32
+}
+
+func (o OptionalVarInt) WriteTo(w io.Writer) (int64, error) {
+	if !o.Has {
+		return pk.VarInt(0).WriteTo(w)
+	}
+
+	return pk.VarInt(o.Val + 1).WriteTo(w)
+}
+
+func (o *OptionalVarInt) ReadFrom(r io.Reader) (int64, error) {
+	var v pk.VarInt
+	n, err := v.ReadFrom(r)
+	o.Has, o.Val = v != 0, int32(v)-1
+
+	return n, err
+}
+
+// GlobalPos is a position in a dimension, e.g. where a player died.
+type GlobalPos struct {
+	Dimension string
+	Pos       pk.Position
+}
+
+func (g GlobalPos) WriteTo(w io.Writer) (int64, error) {
+	return pk.Tuple{pk.Identifier(g.Dimension), g.Pos}.WriteTo(w)
+}
+
+func (g *GlobalPos) ReadFrom(r io.Reader) (int64, error) {
+	return pk.Tuple{(*pk.Identifier)(&g.Dimension), &g.Pos}.ReadFrom(r)
+}
+
+// PaintingVariant is an ID in the minecraft:painting_variant registry, or
+// -1 and the variant inline.
+type PaintingVariant struct {
+	ID      int32
+	Width   int32
+	Height  int32
+	AssetID string
+}
+
+func (p PaintingVariant) WriteTo(w io.Writer) (int64, error) {
+	if p.ID >= 0 {
+		return pk.VarInt(p.ID + 1).WriteTo(w)
+	}
+
+	return pk.Tuple{pk.VarInt(0), pk.VarInt(p.Width), pk.VarInt(p.Height), pk.Identifier(p.AssetID)}.WriteTo(w)
+}
+
+func (p *PaintingVariant) ReadFrom(r io.Reader) (int64, error) {
+	var id pk.VarInt
+	n, err := id.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+
+	*p = PaintingVariant{ID: int32(id) - 1}
+	if id != 0 {
+		return n, nil
+	}
+
+	n1, err := pk.Tuple{(*pk.VarInt)(&p.Width), (*pk.VarInt)(&p.Height), (*pk.Identifier)(&p.AssetID)}.ReadFrom(r)
+
+	return n + n1, err
+}
+
+type codec struct {
+	read  func(r io.Reader) (any, int64, error)
+	write func(w io.Writer, v any) (int64, error)
+}
+
+func codecOf(t Type) (codec, error) {
+	if t < 0 || int(t) >= len(codecs) {
+		return codec{}, errors.New("metadata: unknown type " + strconv.Itoa(int(t)))
+	}
+
+	return codecs[t], nil
+}
+
+type fieldPointer[T any] interface {
+	*T
+	pk.FieldDecoder
+}
+
+func field[T pk.FieldEncoder, P fieldPointer[T]]() codec {
+	return codec{
+		read: func(r io.Reader) (any, int64, error) {
+			var v T
+			n, err := P(&v).ReadFrom(r)
+
+			return v, n, err
+		},
+		write: func(w io.Writer, v any) (int64, error) {
+			f, ok := v.(T)
+			if !ok {
+				return 0, errors.New("metadata: unexpected value " + typeName(v))
+			}
+
+			return f.WriteTo(w)
+		},
+	}
+}
+
+var nbtCodec = codec{
+	read: func(r io.Reader) (any, int64, error) {
+		var v nbt.RawMessage
+		n, err := pk.NBTField{V: &v, AllowUnknownFields: true}.ReadFrom(r)
+
+		return v, n, err
+	},
+	write: func(w io.Writer, v any) (int64, error) {
+		m, ok := v.(nbt.RawMessage)
+		if !ok {
+			return 0, errors.New("metadata: unexpected value " + typeName(v))
+		}
+
+		if m.Type == nbt.TagEnd {
+			return pk.NBT(nil).WriteTo(w)
+		}
+
+		return pk.NBT(m).WriteTo(w)
+	},
+}
+
+var particlesCodec = codec{
+	read: func(r io.Reader) (any, int64, error) {
+		var v []pk.Particle
+		n, err := pk.Array(&v).ReadFrom(r)
+
+		return v, n, err
+	},
+	write: func(w io.Writer, v any) (int64, error) {
+		p, ok := v.([]pk.Particle)
+		if !ok {
+			return 0, errors.New("metadata: unexpected value " + typeName(v))
+		}
+
+		return pk.Array(p).WriteTo(w)
+	},
+}
+
+var codecs = [...]codec{
+	TypeByte:               field[pk.Byte](),
+	TypeVarInt:             field[pk.VarInt](),
+	TypeVarLong:            field[pk.VarLong](),
+	TypeFloat:              field[pk.Float](),
+	TypeString:             field[pk.String](),
+	TypeComponent:          field[chat.Message](),
+	TypeOptionalComponent:  field[pk.Option[chat.Message, *chat.Message]](),
+	TypeSlot:               field[pk.Slot](),
+	TypeBoolean:            field[pk.Boolean](),
+	TypeRotations:          field[Vector3](),
+	TypePosition:           field[pk.Position](),
+	TypeOptionalPosition:   field[pk.Option[pk.Position, *pk.Position]](),
+	TypeDirection:          field[pk.VarInt](),
+	TypeOptionalUUID:       field[pk.Option[pk.UUID, *pk.UUID]](),
+	TypeBlockState:         field[pk.VarInt](),
+	TypeOptionalBlockState: field[pk.VarInt](),
+	TypeNBT:                nbtCodec,
+	TypeParticle:           field[pk.Particle](),
+	TypeParticles:          particlesCodec,
+	TypeVillagerData:       field[VillagerData](),
+	TypeOptionalVarInt:     field[OptionalVarInt](),
+	TypePose:               field[pk.VarInt](),
+	TypeCatVariant:         field[pk.VarInt](),
+	TypeWolfVariant:        field[pk.VarInt](),
+	TypeFrogVariant:        field[pk.VarInt](),
+	TypeOptionalGlobalPos:  field[pk.Option[GlobalPos, *GlobalPos]](),
+	TypePaintingVariant:    field[PaintingVariant](),
+	TypeSnifferState:       field[pk.VarInt](),
+	TypeArmadilloState:     field[pk.VarInt](),
+	TypeVector3:            field[Vector3](),
+	TypeQuaternion:         field[Quaternion](),
+}
+
+func typeName(v any) string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	return reflect.TypeOf(v).String()
+}
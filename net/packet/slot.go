@@ -0,0 +1,488 @@
+package packet
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+
+	"mcAfkGo/data/registryid"
+	"mcAfkGo/nbt"
+)
+
+// Slot is an item stack. Its data components are kept encoded, Type being
+// the ID in the minecraft:data_component_type registry of protocol 767, see
+// registryid.DataComponentType.
+type Slot struct {
+	Count      int32
+	ItemID     int32
+	Components []Component
+	// Removed are the types of the item's default components the stack
+	// doesn't have.
+	Removed []int32
+}
+
+type Component struct {
+	Type int32
+	Data []byte
+}
+
+// IsEmpty reports whether the slot holds no item.
+func (s *Slot) IsEmpty() bool {
+	return s.Count <= 0
+}
+
+// Component returns the encoded data of the component type, nil if the
+// stack doesn't override it.
+func (s *Slot) Component(typ int32) []byte {
+	for _, c := range s.Components {
+		if c.Type == typ {
+			return c.Data
+		}
+	}
+
+	return nil
+}
+
+func (s Slot) WriteTo(w io.Writer) (int64, error) {
+	if s.IsEmpty() {
+		return VarInt(0).WriteTo(w)
+	}
+
+	n, err := Tuple{
+		VarInt(s.Count),
+		VarInt(s.ItemID),
+		VarInt(len(s.Components)),
+		VarInt(len(s.Removed)),
+	}.WriteTo(w)
+	if err != nil {
+		return n, err
+	}
+
+	for _, c := range s.Components {
+		n1, err := Tuple{VarInt(c.Type), PluginMessageData(c.Data)}.WriteTo(w)
+		n += n1
+		if err != nil {
+			return n, err
+		}
+	}
+
+	for _, t := range s.Removed {
+		n1, err := VarInt(t).WriteTo(w)
+		n += n1
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+func (s *Slot) ReadFrom(r io.Reader) (int64, error) {
+	*s = Slot{}
+
+	n, err := (*VarInt)(&s.Count).ReadFrom(r)
+	if err != nil || s.Count <= 0 {
+		return n, err
+	}
+
+	var added, removed VarInt
+	n1, err := Tuple{(*VarInt)(&s.ItemID), &added, &removed}.ReadFrom(r)
+	n += n1
+	if err != nil {
+		return n, err
+	}
+
+	if added < 0 || removed < 0 || added+removed > maxComponents {
+		return n, errors.New("invalid component count " + strconv.Itoa(int(added+removed)))
+	}
+
+	s.Components = make([]Component, added)
+	for i := range s.Components {
+		c := &s.Components[i]
+
+		n1, err = (*VarInt)(&c.Type).ReadFrom(r)
+		n += n1
+		if err != nil {
+			return n, err
+		}
+
+		c.Data, n1, err = readEncoded(r, componentWire(c.Type))
+		n += n1
+		if err != nil {
+			return n, errors.New("component " + strconv.Itoa(int(c.Type)) + ": " + err.Error())
+		}
+	}
+
+	s.Removed = make([]int32, removed)
+	for i := range s.Removed {
+		n1, err = (*VarInt)(&s.Removed[i]).ReadFrom(r)
+		n += n1
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// Particle is a particle type, an ID in the minecraft:particle_type
+// registry of protocol 767 (registryid.ParticleType), and its options, kept
+// encoded.
+type Particle struct {
+	Type    int32
+	Options []byte
+}
+
+func (p Particle) WriteTo(w io.Writer) (int64, error) {
+	return Tuple{VarInt(p.Type), PluginMessageData(p.Options)}.WriteTo(w)
+}
+
+func (p *Particle) ReadFrom(r io.Reader) (int64, error) {
+	n, err := (*VarInt)(&p.Type).ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+
+	var n1 int64
+	p.Options, n1, err = readEncoded(r, particleWire(p.Type))
+
+	return n + n1, err
+}
+
+// readEncoded returns the bytes the value of shape w takes.
+func readEncoded(r io.Reader, w wire) ([]byte, int64, error) {
+	if w == nil {
+		return nil, 0, errors.New("unknown type")
+	}
+
+	var buf bytes.Buffer
+	n, err := w(io.TeeReader(r, &buf))
+
+	return buf.Bytes(), n, err
+}
+
+// wire reads past a value of some shape.
+type wire func(r io.Reader) (int64, error)
+
+func read[T any, P fieldPointer[T]]() wire {
+	return func(r io.Reader) (int64, error) {
+		var v T
+
+		return P(&v).ReadFrom(r)
+	}
+}
+
+var (
+	wireVarInt  = read[VarInt]()
+	wireInt     = read[Int]()
+	wireFloat   = read[Float]()
+	wireDouble  = read[Double]()
+	wireBoolean = read[Boolean]()
+	wireString  = read[String]()
+	wireUUID    = read[UUID]()
+	wirePos     = read[Position]()
+	wireNothing = seq()
+)
+
+func wireNBT(r io.Reader) (int64, error) {
+	var v nbt.RawMessage
+
+	return NBTField{V: &v, AllowUnknownFields: true}.ReadFrom(r)
+}
+
+func wireSlot(r io.Reader) (int64, error) {
+	var s Slot
+
+	return s.ReadFrom(r)
+}
+
+func seq(ws ...wire) wire {
+	return func(r io.Reader) (n int64, err error) {
+		for _, w := range ws {
+			n1, err := w(r)
+			n += n1
+			if err != nil {
+				return n, err
+			}
+		}
+
+		return n, nil
+	}
+}
+
+func list(ws ...wire) wire {
+	elem := seq(ws...)
+
+	return func(r io.Reader) (int64, error) {
+		var count VarInt
+		n, err := count.ReadFrom(r)
+		if err != nil {
+			return n, err
+		}
+
+		if count < 0 {
+			return n, errors.New("list length less than zero")
+		}
+
+		for range count {
+			n1, err := elem(r)
+			n += n1
+			if err != nil {
+				return n, err
+			}
+		}
+
+		return n, nil
+	}
+}
+
+func opt(ws ...wire) wire {
+	return either(seq(ws...), wireNothing)
+}
+
+// either reads a boolean and then left if it is true, right if not.
+func either(left, right wire) wire {
+	return func(r io.Reader) (int64, error) {
+		var isLeft Boolean
+		n, err := isLeft.ReadFrom(r)
+		if err != nil {
+			return n, err
+		}
+
+		w := right
+		if isLeft {
+			w = left
+		}
+
+		n1, err := w(r)
+
+		return n + n1, err
+	}
+}
+
+// holder reads a registry reference: an ID plus one, or 0 and the value
+// inline.
+func holder(direct ...wire) wire {
+	inline := seq(direct...)
+
+	return func(r io.Reader) (int64, error) {
+		var id VarInt
+		n, err := id.ReadFrom(r)
+		if err != nil || id != 0 {
+			return n, err
+		}
+
+		n1, err := inline(r)
+
+		return n + n1, err
+	}
+}
+
+// holderSet reads a tag name if the leading count is 0, else count-1 IDs.
+func holderSet(r io.Reader) (int64, error) {
+	var count VarInt
+	n, err := count.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+
+	if count == 0 {
+		n1, err := wireString(r)
+
+		return n + n1, err
+	}
+
+	for range count - 1 {
+		n1, err := wireVarInt(r)
+		n += n1
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// maxComponents bounds the components of a stack, well above the number
+// of component types.
+const maxComponents = 256
+
+var (
+	wireVec3         = seq(wireFloat, wireFloat, wireFloat)
+	wireSoundEvent   = holder(wireString, opt(wireFloat))
+	wireEnchantments = seq(list(wireVarInt, wireVarInt), wireBoolean)
+
+	wireBlockPredicates = seq(list(
+		opt(holderSet),
+		opt(list(wireString, either(wireString, seq(opt(wireString), opt(wireString))))),
+		opt(wireNBT),
+	), wireBoolean)
+
+	wireAttributeModifiers = seq(list(wireVarInt, wireString, wireDouble, wireVarInt, wireVarInt), wireBoolean)
+	wireFood               = seq(wireVarInt, wireFloat, wireBoolean, wireFloat, opt(wireSlot), list(wireEffect, wireFloat))
+	wireTool               = seq(list(holderSet, opt(wireFloat), opt(wireBoolean)), wireFloat, wireVarInt)
+	wirePotionContents     = seq(opt(wireVarInt), opt(wireInt), list(wireEffect))
+	wireWrittenBook        = seq(wireString, opt(wireString), wireString, wireVarInt, list(wireNBT, opt(wireNBT)), wireBoolean)
+	wireInstrument         = holder(wireSoundEvent, wireVarInt, wireFloat)
+	wireJukeboxPlayable    = seq(either(holder(wireSoundEvent, wireNBT, wireFloat, wireVarInt), wireString), wireBoolean)
+	wireLodestoneTracker   = seq(opt(wireString, wirePos), wireBoolean)
+	wireFireworkExplosion  = seq(wireVarInt, list(wireInt), list(wireInt), wireBoolean, wireBoolean)
+	wireFireworks          = seq(wireVarInt, list(wireFireworkExplosion))
+	wireBannerPatterns     = list(holder(wireString, wireString), wireVarInt)
+	wireProfile            = seq(opt(wireString), opt(wireUUID), list(wireString, wireString, opt(wireString)))
+
+	wireTrim = seq(
+		holder(wireString, wireVarInt, wireFloat, list(wireVarInt, wireString), wireNBT),
+		holder(wireString, wireVarInt, wireNBT, wireBoolean),
+		wireBoolean,
+	)
+)
+
+func wireEffectDetails(r io.Reader) (int64, error) {
+	// The hidden effect nests further details.
+	return seq(wireVarInt, wireVarInt, wireBoolean, wireBoolean, wireBoolean, opt(wireEffectDetails))(r)
+}
+
+var wireEffect = seq(wireVarInt, wireEffectDetails)
+
+// componentWires are the shapes of the data component types, by ID. They
+// are set in init as stacks nest in components.
+var componentWires []wire
+
+func init() {
+	byName := map[string]wire{
+		"minecraft:custom_data":                wireNBT,
+		"minecraft:max_stack_size":             wireVarInt,
+		"minecraft:max_damage":                 wireVarInt,
+		"minecraft:damage":                     wireVarInt,
+		"minecraft:unbreakable":                wireBoolean,
+		"minecraft:custom_name":                wireNBT,
+		"minecraft:item_name":                  wireNBT,
+		"minecraft:lore":                       list(wireNBT),
+		"minecraft:rarity":                     wireVarInt,
+		"minecraft:enchantments":               wireEnchantments,
+		"minecraft:can_place_on":               wireBlockPredicates,
+		"minecraft:can_break":                  wireBlockPredicates,
+		"minecraft:attribute_modifiers":        wireAttributeModifiers,
+		"minecraft:custom_model_data":          wireVarInt,
+		"minecraft:hide_additional_tooltip":    wireNothing,
+		"minecraft:hide_tooltip":               wireNothing,
+		"minecraft:repair_cost":                wireVarInt,
+		"minecraft:creative_slot_lock":         wireNothing,
+		"minecraft:enchantment_glint_override": wireBoolean,
+		"minecraft:intangible_projectile":      wireNBT,
+		"minecraft:food":                       wireFood,
+		"minecraft:fire_resistant":             wireNothing,
+		"minecraft:tool":                       wireTool,
+		"minecraft:stored_enchantments":        wireEnchantments,
+		"minecraft:dyed_color":                 seq(wireInt, wireBoolean),
+		"minecraft:map_color":                  wireInt,
+		"minecraft:map_id":                     wireVarInt,
+		"minecraft:map_decorations":            wireNBT,
+		"minecraft:map_post_processing":        wireVarInt,
+		"minecraft:charged_projectiles":        list(wireSlot),
+		"minecraft:bundle_contents":            list(wireSlot),
+		"minecraft:potion_contents":            wirePotionContents,
+		"minecraft:suspicious_stew_effects":    list(wireVarInt, wireVarInt),
+		"minecraft:writable_book_content":      list(wireString, opt(wireString)),
+		"minecraft:written_book_content":       wireWrittenBook,
+		"minecraft:trim":                       wireTrim,
+		"minecraft:debug_stick_state":          wireNBT,
+		"minecraft:entity_data":                wireNBT,
+		"minecraft:bucket_entity_data":         wireNBT,
+		"minecraft:block_entity_data":          wireNBT,
+		"minecraft:instrument":                 wireInstrument,
+		"minecraft:ominous_bottle_amplifier":   wireVarInt,
+		"minecraft:jukebox_playable":           wireJukeboxPlayable,
+		"minecraft:recipes":                    wireNBT,
+		"minecraft:lodestone_tracker":          wireLodestoneTracker,
+		"minecraft:firework_explosion":         wireFireworkExplosion,
+		"minecraft:fireworks":                  wireFireworks,
+		"minecraft:profile":                    wireProfile,
+		"minecraft:note_block_sound":           wireString,
+		"minecraft:banner_patterns":            wireBannerPatterns,
+		"minecraft:base_color":                 wireVarInt,
+		"minecraft:pot_decorations":            list(wireVarInt),
+		"minecraft:container":                  list(wireSlot),
+		"minecraft:block_state":                list(wireString, wireString),
+		"minecraft:bees":                       list(wireNBT, wireVarInt, wireVarInt),
+		"minecraft:lock":                       wireNBT,
+		"minecraft:container_loot":             wireNBT,
+	}
+
+	componentWires = make([]wire, len(registryid.DataComponentType))
+	for id, name := range registryid.DataComponentType {
+		componentWires[id] = byName[name]
+	}
+}
+
+func componentWire(typ int32) wire {
+	if typ < 0 || int(typ) >= len(componentWires) {
+		return nil
+	}
+
+	return componentWires[typ]
+}
+
+// particleWires are the shapes of the options of the particle types that
+// have any, by ID.
+var particleWires = registryWires(registryid.ParticleType, map[string]wire{
+	"minecraft:block":                 wireVarInt,
+	"minecraft:block_marker":          wireVarInt,
+	"minecraft:dust":                  seq(wireVec3, wireFloat),
+	"minecraft:dust_color_transition": seq(wireVec3, wireVec3, wireFloat),
+	"minecraft:entity_effect":         wireInt,
+	"minecraft:falling_dust":          wireVarInt,
+	"minecraft:sculk_charge":          wireFloat,
+	"minecraft:item":                  wireSlot,
+	"minecraft:vibration":             seq(positionSource, wireVarInt),
+	"minecraft:shriek":                wireVarInt,
+	"minecraft:dust_pillar":           wireVarInt,
+})
+
+// registryWires returns the shapes of the named entries of a registry by
+// their ID.
+func registryWires(entries []string, byName map[string]wire) map[int32]wire {
+	wires := make(map[int32]wire, len(byName))
+	for name, w := range byName {
+		if id := registryid.ID(entries, name); id >= 0 {
+			wires[id] = w
+		}
+	}
+
+	return wires
+}
+
+// positionSource is a block position or an entity and its eye offset.
+func positionSource(r io.Reader) (int64, error) {
+	var typ VarInt
+	n, err := typ.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+
+	var n1 int64
+	switch typ {
+	case 0:
+		n1, err = wirePos(r)
+	case 1:
+		n1, err = seq(wireVarInt, wireFloat)(r)
+	default:
+		err = errors.New("unknown position source " + strconv.Itoa(int(typ)))
+	}
+
+	return n + n1, err
+}
+
+func particleWire(typ int32) wire {
+	if w, ok := particleWires[typ]; ok {
+		return w
+	}
+
+	if typ < 0 || int(typ) >= len(registryid.ParticleType) {
+		return nil
+	}
+
+	return wireNothing
+}
@@ -0,0 +1,236 @@
+package packet
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"mcAfkGo/data/registryid"
+)
+
+// SlotLayout is how an older protocol version lays out item stacks.
+// ConvertSlot rewrites such a stack into the layout of protocol 767, the
+// one Slot reads.
+type SlotLayout struct {
+	// Components maps the version's data component type IDs, the index,
+	// to the ones of protocol 767.
+	Components []int32
+	// Convert rewrites the data of the component types, by their 767 ID,
+	// that the version encodes differently.
+	Convert map[int32]func(r io.Reader, w io.Writer) error
+}
+
+// stackLists are the component types that are lists of stacks.
+var stackLists = map[string]bool{
+	"minecraft:charged_projectiles": true,
+	"minecraft:bundle_contents":     true,
+	"minecraft:container":           true,
+}
+
+// ConvertSlot reads a stack laid out as l says and writes it the way of
+// protocol 767.
+func (l *SlotLayout) ConvertSlot(r io.Reader, w io.Writer) error {
+	var count VarInt
+	if _, err := count.ReadFrom(r); err != nil {
+		return err
+	}
+
+	if count <= 0 {
+		_, err := count.WriteTo(w)
+
+		return err
+	}
+
+	var item, added, removed VarInt
+	_, err := Tuple{&item, &added, &removed}.ReadFrom(r)
+	if err != nil {
+		return err
+	}
+
+	if added < 0 || removed < 0 || added+removed > maxComponents {
+		return errors.New("invalid component count " + strconv.Itoa(int(added+removed)))
+	}
+
+	_, err = Tuple{count, item, added, removed}.WriteTo(w)
+	if err != nil {
+		return err
+	}
+
+	for range added {
+		typ, err := l.readType(r)
+		if err != nil {
+			return err
+		}
+
+		if _, err := VarInt(typ).WriteTo(w); err != nil {
+			return err
+		}
+
+		err = l.convertComponent(typ, r, w)
+		if err != nil {
+			return errors.New("component " + strconv.Itoa(int(typ)) + ": " + err.Error())
+		}
+	}
+
+	for range removed {
+		typ, err := l.readType(r)
+		if err != nil {
+			return err
+		}
+
+		if _, err := VarInt(typ).WriteTo(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readType reads a component type and returns its 767 ID.
+func (l *SlotLayout) readType(r io.Reader) (int32, error) {
+	var typ VarInt
+	if _, err := typ.ReadFrom(r); err != nil {
+		return 0, err
+	}
+
+	if typ < 0 || int(typ) >= len(l.Components) {
+		return 0, errors.New("unknown component type " + strconv.Itoa(int(typ)))
+	}
+
+	return l.Components[typ], nil
+}
+
+func (l *SlotLayout) convertComponent(typ int32, r io.Reader, w io.Writer) error {
+	if convert, ok := l.Convert[typ]; ok {
+		return convert(r, w)
+	}
+
+	if !stackLists[registryid.DataComponentType[typ]] {
+		data, _, err := readEncoded(r, componentWire(typ))
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(data)
+
+		return err
+	}
+
+	var count VarInt
+	if _, err := count.ReadFrom(r); err != nil {
+		return err
+	}
+
+	if count < 0 {
+		return errors.New("list length less than zero")
+	}
+
+	if _, err := count.WriteTo(w); err != nil {
+		return err
+	}
+
+	for range count {
+		if err := l.ConvertSlot(r, w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SlotLayout766 is the stack layout of protocol 766 (1.20.5). It lacks the
+// jukebox_playable component, its food can't turn into another item once
+// eaten and its attribute modifiers are named by a UUID and a name instead
+// of an identifier.
+var SlotLayout766 = &SlotLayout{
+	Components: components766(),
+	Convert: map[int32]func(r io.Reader, w io.Writer) error{
+		registryid.ID(registryid.DataComponentType, "minecraft:attribute_modifiers"): convertAttributeModifiers766,
+		registryid.ID(registryid.DataComponentType, "minecraft:food"):                convertFood766,
+	},
+}
+
+// components766 maps the component types of 766, which are those of 767
+// without jukebox_playable.
+func components766() []int32 {
+	var ids []int32
+	for id, name := range registryid.DataComponentType {
+		if name != "minecraft:jukebox_playable" {
+			ids = append(ids, int32(id))
+		}
+	}
+
+	return ids
+}
+
+// convertAttributeModifiers766 names every modifier after its UUID, as
+// identifiers took the place of the UUIDs and names in 767.
+func convertAttributeModifiers766(r io.Reader, w io.Writer) error {
+	var count VarInt
+	if _, err := count.ReadFrom(r); err != nil {
+		return err
+	}
+
+	if count < 0 {
+		return errors.New("list length less than zero")
+	}
+
+	if _, err := count.WriteTo(w); err != nil {
+		return err
+	}
+
+	for range count {
+		var (
+			attribute, operation, slot VarInt
+			id                         UUID
+			name                       String
+			amount                     Double
+		)
+
+		_, err := Tuple{&attribute, &id, &name, &amount, &operation, &slot}.ReadFrom(r)
+		if err != nil {
+			return err
+		}
+
+		_, err = Tuple{attribute, Identifier("minecraft:" + uuid.UUID(id).String()), amount, operation, slot}.WriteTo(w)
+		if err != nil {
+			return err
+		}
+	}
+
+	var showInTooltip Boolean
+	if _, err := showInTooltip.ReadFrom(r); err != nil {
+		return err
+	}
+
+	_, err := showInTooltip.WriteTo(w)
+
+	return err
+}
+
+// convertFood766 adds the empty using_converts_to stack of 767 between the
+// eating time and the effects.
+func convertFood766(r io.Reader, w io.Writer) error {
+	var (
+		nutrition    VarInt
+		saturation   Float
+		canAlwaysEat Boolean
+		eatSeconds   Float
+	)
+
+	_, err := Tuple{&nutrition, &saturation, &canAlwaysEat, &eatSeconds}.ReadFrom(r)
+	if err != nil {
+		return err
+	}
+
+	effects, _, err := readEncoded(r, list(wireEffect, wireFloat))
+	if err != nil {
+		return err
+	}
+
+	_, err = Tuple{nutrition, saturation, canAlwaysEat, eatSeconds, Boolean(false), PluginMessageData(effects)}.WriteTo(w)
+
+	return err
+}
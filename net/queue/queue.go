@@ -11,6 +11,12 @@ type Queue[T any] interface {
 	Close()
 }
 
+// TryPuller is a Queue that can be pulled from without waiting.
+type TryPuller[T any] interface {
+	// TryPull takes the oldest value, ok is false if there is none.
+	TryPull() (v T, ok bool)
+}
+
 func NewLinkedQueue[T any]() (q Queue[T]) {
 	return &LinkedListQueue[T]{
 		queue: list.New(),
@@ -26,13 +32,14 @@ type LinkedListQueue[T any] struct {
 
 func (p *LinkedListQueue[T]) Push(v T) bool {
 	p.cond.L.Lock()
+	defer p.cond.L.Unlock()
+
 	if p.closed {
-		panic("push on closed queue")
+		return false
 	}
 
 	p.queue.PushBack(v)
 	p.cond.Signal()
-	p.cond.L.Unlock()
 
 	return true
 }
@@ -57,6 +64,18 @@ func (p *LinkedListQueue[T]) Pull() (v T, ok bool) {
 	return
 }
 
+func (p *LinkedListQueue[T]) TryPull() (v T, ok bool) {
+	p.cond.L.Lock()
+	defer p.cond.L.Unlock()
+
+	elem := p.queue.Front()
+	if elem == nil {
+		return v, false
+	}
+
+	return p.queue.Remove(elem).(T), true
+}
+
 func (p *LinkedListQueue[T]) Close() {
 	p.cond.L.Lock()
 	p.closed = true
@@ -81,6 +100,15 @@ func (c ChannelQueue[T]) Pull() (v T, ok bool) {
 	return
 }
 
+func (c ChannelQueue[T]) TryPull() (v T, ok bool) {
+	select {
+	case v, ok = <-c:
+	default:
+	}
+
+	return
+}
+
 func (c ChannelQueue[T]) Close() {
 	close(c)
 }
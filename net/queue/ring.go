@@ -0,0 +1,298 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var ErrClosed = errors.New("queue closed")
+
+// Policy picks what gives way when a RingQueue is under pressure, from
+// the moment it fills up to its high watermark until it drained to its low
+// one. Values neither dropped nor merged wait for room.
+type Policy[T any] struct {
+	// Droppable reports whether v may be dropped under pressure.
+	Droppable func(v T) bool
+	// Key groups the values Merge may combine, ok is false for ones it
+	// can't.
+	Key func(v T) (key any, ok bool)
+	// Merge combines newer into older, the last queued value with the same
+	// key, without changing what the consumer ends up with. ok is false if
+	// the two can't be combined.
+	Merge func(older, newer T) (merged T, ok bool)
+	// Resets reports whether v makes the values of key queued before it
+	// moot, like the removal of an entity does its moves. Nothing is merged
+	// past such a value: the older value of the key is dropped and the
+	// newer one queued.
+	Resets func(v T, key any) bool
+}
+
+type RingOptions[T any] struct {
+	Capacity int
+	// HighWater and LowWater default to 3/4 and 1/4 of Capacity.
+	HighWater, LowWater int
+	Policy              Policy[T]
+}
+
+// Stats are counters of a RingQueue since it was made.
+type Stats struct {
+	Len int `json:"len"`
+	Cap int `json:"cap"`
+	// MaxLen is the highest Len so far.
+	MaxLen int `json:"max_len"`
+	// Pressure is set while the queue is under pressure, Pressured counts
+	// how often it came under pressure.
+	Pressure  bool `json:"pressure"`
+	Pressured int  `json:"pressured"`
+	Dropped   int  `json:"dropped"`
+	Merged    int  `json:"merged"`
+	// Waited counts the values pushed that had to wait for room.
+	Waited int `json:"waited"`
+}
+
+// RingQueue is a bounded queue on a ring buffer. A full queue makes Push
+// wait, which holds up the producer instead of using up memory.
+type RingQueue[T any] struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	// notFull is closed and replaced whenever room is made, so a push can
+	// wait for it together with its context.
+	notFull chan struct{}
+
+	buf        []T
+	head, size int
+	closed     bool
+
+	high, low int
+	policy    Policy[T]
+	stats     Stats
+}
+
+func NewRingQueue[T any](options RingOptions[T]) *RingQueue[T] {
+	capacity := max(options.Capacity, 1)
+
+	q := &RingQueue[T]{
+		notFull: make(chan struct{}),
+		buf:     make([]T, capacity),
+		high:    options.HighWater,
+		low:     options.LowWater,
+		policy:  options.Policy,
+		stats:   Stats{Cap: capacity},
+	}
+
+	q.notEmpty = sync.NewCond(&q.mu)
+
+	if q.high <= 0 || q.high > capacity {
+		q.high = capacity * 3 / 4
+	}
+
+	if q.low <= 0 || q.low >= q.high {
+		q.low = q.high / 3
+	}
+
+	return q
+}
+
+// Push adds v, waiting for room if needed. It returns false if the queue
+// is closed.
+func (q *RingQueue[T]) Push(v T) bool {
+	return q.PushContext(context.Background(), v) == nil
+}
+
+// PushContext adds v, waiting for room until ctx is done.
+func (q *RingQueue[T]) PushContext(ctx context.Context, v T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	waited := false
+	for {
+		if q.closed {
+			return ErrClosed
+		}
+
+		if q.stats.Pressure && q.shed(v) {
+			return nil
+		}
+
+		if q.size < len(q.buf) {
+			break
+		}
+
+		if !waited {
+			waited = true
+			q.stats.Waited++
+		}
+
+		notFull := q.notFull
+		q.mu.Unlock()
+
+		select {
+		case <-notFull:
+		case <-ctx.Done():
+			q.mu.Lock()
+
+			return ctx.Err()
+		}
+
+		q.mu.Lock()
+	}
+
+	q.buf[(q.head+q.size)%len(q.buf)] = v
+	q.size++
+
+	q.stats.MaxLen = max(q.stats.MaxLen, q.size)
+	if !q.stats.Pressure && q.size >= q.high {
+		q.stats.Pressure = true
+		q.stats.Pressured++
+	}
+
+	q.notEmpty.Signal()
+
+	return nil
+}
+
+// shed drops v or merges it into a queued value, as the policy allows.
+func (q *RingQueue[T]) shed(v T) bool {
+	if q.policy.Droppable != nil && q.policy.Droppable(v) {
+		q.stats.Dropped++
+
+		return true
+	}
+
+	if q.policy.Key == nil || q.policy.Merge == nil {
+		return false
+	}
+
+	key, ok := q.policy.Key(v)
+	if !ok {
+		return false
+	}
+
+	for i := q.size - 1; i >= 0; i-- {
+		j := (q.head + i) % len(q.buf)
+
+		if q.policy.Resets != nil && q.policy.Resets(q.buf[j], key) {
+			q.dropBefore(i, key)
+
+			return false
+		}
+
+		if other, ok := q.policy.Key(q.buf[j]); !ok || other != key {
+			continue
+		}
+
+		merged, ok := q.policy.Merge(q.buf[j], v)
+		if !ok {
+			return false
+		}
+
+		q.buf[j] = merged
+		q.stats.Merged++
+
+		return true
+	}
+
+	return false
+}
+
+// dropBefore drops the last value of key queued before the i-th one,
+// making room for the value that can't be merged into it.
+func (q *RingQueue[T]) dropBefore(i int, key any) {
+	for i--; i >= 0; i-- {
+		if other, ok := q.policy.Key(q.buf[(q.head+i)%len(q.buf)]); ok && other == key {
+			break
+		}
+	}
+
+	if i < 0 {
+		return
+	}
+
+	for ; i < q.size-1; i++ {
+		q.buf[(q.head+i)%len(q.buf)] = q.buf[(q.head+i+1)%len(q.buf)]
+	}
+
+	var zero T
+	q.buf[(q.head+q.size-1)%len(q.buf)] = zero
+	q.size--
+	q.stats.Dropped++
+
+	q.madeRoom()
+}
+
+// Pull takes the oldest value, waiting for one. ok is false once the queue
+// is closed and empty.
+func (q *RingQueue[T]) Pull() (v T, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.size == 0 {
+		if q.closed {
+			return v, false
+		}
+
+		q.notEmpty.Wait()
+	}
+
+	return q.take(), true
+}
+
+func (q *RingQueue[T]) TryPull() (v T, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.size == 0 {
+		return v, false
+	}
+
+	return q.take(), true
+}
+
+// take removes the oldest value of a non-empty queue.
+func (q *RingQueue[T]) take() (v T) {
+	var zero T
+	v, q.buf[q.head] = q.buf[q.head], zero
+	q.head = (q.head + 1) % len(q.buf)
+	q.size--
+
+	q.madeRoom()
+
+	return v
+}
+
+// madeRoom ends the pressure once the queue drained to its low watermark
+// and wakes the pushes waiting for room.
+func (q *RingQueue[T]) madeRoom() {
+	if q.stats.Pressure && q.size <= q.low {
+		q.stats.Pressure = false
+	}
+
+	close(q.notFull)
+	q.notFull = make(chan struct{})
+}
+
+// Close makes Push fail and Pull return the values left, then fail.
+func (q *RingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	q.notEmpty.Broadcast()
+	close(q.notFull)
+	q.notFull = make(chan struct{})
+}
+
+func (q *RingQueue[T]) Stats() Stats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stats := q.stats
+	stats.Len = q.size
+
+	return stats
+}
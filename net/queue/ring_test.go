@@ -0,0 +1,219 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// item is a value of the test queue: moves of a key merge by adding up
+// their values, noise may be dropped and a reset makes the moves of its
+// key before it moot.
+type item struct {
+	kind string
+	key  int
+	v    int
+}
+
+func (i item) String() string { return fmt.Sprintf("%s %d:%d", i.kind, i.key, i.v) }
+
+func move(key, v int) item { return item{kind: "move", key: key, v: v} }
+
+func reset(key int) item { return item{kind: "reset", key: key} }
+
+var (
+	noise = item{kind: "noise"}
+	other = item{kind: "other"}
+)
+
+func newTestQueue(capacity, high, low int) *RingQueue[item] {
+	return NewRingQueue(RingOptions[item]{
+		Capacity:  capacity,
+		HighWater: high,
+		LowWater:  low,
+		Policy: Policy[item]{
+			Droppable: func(v item) bool { return v.kind == "noise" },
+			Key: func(v item) (any, bool) {
+				return v.key, v.kind == "move"
+			},
+			Merge: func(older, newer item) (item, bool) {
+				return move(older.key, older.v+newer.v), true
+			},
+			Resets: func(v item, key any) bool { return v.kind == "reset" && v.key == key },
+		},
+	})
+}
+
+// pullAll takes the values left in q.
+func pullAll(q *RingQueue[item]) string {
+	var values []item
+	for {
+		v, ok := q.TryPull()
+		if !ok {
+			return fmt.Sprint(values)
+		}
+
+		values = append(values, v)
+	}
+}
+
+func TestRingQueueShed(t *testing.T) {
+	tests := []struct {
+		name string
+		// queued are pushed before, under pressure from the first on.
+		queued []item
+		push   item
+		want   string
+		// wantDropped and wantMerged are the stats after the push.
+		wantDropped, wantMerged int
+	}{
+		{
+			name:        "dropped",
+			queued:      []item{other},
+			push:        noise,
+			want:        "[other 0:0]",
+			wantDropped: 1,
+		},
+		{
+			name:       "merged past others",
+			queued:     []item{move(1, 1), move(2, 5), other},
+			push:       move(1, 2),
+			want:       "[move 1:3 move 2:5 other 0:0]",
+			wantMerged: 1,
+		},
+		{
+			name:   "no older move",
+			queued: []item{move(2, 5)},
+			push:   move(1, 2),
+			want:   "[move 2:5 move 1:2]",
+		},
+		{
+			name:        "reset between",
+			queued:      []item{move(1, 1), other, reset(1), other},
+			push:        move(1, 2),
+			want:        "[other 0:0 reset 1:0 other 0:0 move 1:2]",
+			wantDropped: 1,
+		},
+		{
+			name:   "reset without an older move",
+			queued: []item{reset(1)},
+			push:   move(1, 2),
+			want:   "[reset 1:0 move 1:2]",
+		},
+		{
+			name:       "reset of another key",
+			queued:     []item{move(1, 1), reset(2)},
+			push:       move(1, 2),
+			want:       "[move 1:3 reset 2:0]",
+			wantMerged: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQueue(8, 1, 0)
+			for _, v := range tt.queued {
+				q.Push(v)
+			}
+
+			q.Push(tt.push)
+
+			stats := q.Stats()
+			if stats.Dropped != tt.wantDropped || stats.Merged != tt.wantMerged {
+				t.Errorf("dropped %d, merged %d, want %d, %d", stats.Dropped, stats.Merged, tt.wantDropped, tt.wantMerged)
+			}
+
+			if got := pullAll(q); got != tt.want {
+				t.Errorf("queued %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRingQueueWatermarks(t *testing.T) {
+	// Under pressure from 6 values until drained to 2.
+	q := newTestQueue(8, 6, 2)
+
+	check := func(want Stats) {
+		t.Helper()
+
+		if got := q.Stats(); got != want {
+			t.Errorf("stats %+v, want %+v", got, want)
+		}
+	}
+
+	for range 5 {
+		q.Push(other)
+	}
+
+	q.Push(noise)
+	check(Stats{Len: 6, Cap: 8, MaxLen: 6, Pressure: true, Pressured: 1})
+
+	q.Push(noise)
+	q.Push(move(1, 1))
+	q.Push(move(1, 1))
+	check(Stats{Len: 7, Cap: 8, MaxLen: 7, Pressure: true, Pressured: 1, Dropped: 1, Merged: 1})
+
+	// Still under pressure above the low watermark.
+	for range 4 {
+		q.TryPull()
+	}
+
+	q.Push(noise)
+	check(Stats{Len: 3, Cap: 8, MaxLen: 7, Pressure: true, Pressured: 1, Dropped: 2, Merged: 1})
+
+	q.TryPull()
+	q.Push(noise)
+	check(Stats{Len: 3, Cap: 8, MaxLen: 7, Pressured: 1, Dropped: 2, Merged: 1})
+}
+
+func TestRingQueuePushContext(t *testing.T) {
+	q := NewRingQueue(RingOptions[int]{Capacity: 1})
+	q.Push(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := q.PushContext(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("push to a full queue = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// A pull makes room for the waiting push.
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		q.Pull()
+	}()
+
+	if err := q.PushContext(context.Background(), 3); err != nil {
+		t.Fatalf("push after a pull: %v", err)
+	}
+
+	if v, _ := q.TryPull(); v != 3 {
+		t.Errorf("pulled %d, want 3", v)
+	}
+
+	if stats := q.Stats(); stats.Waited != 2 {
+		t.Errorf("waited %d times, want 2", stats.Waited)
+	}
+
+	// Closing wakes a waiting push.
+	q.Push(4)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		q.Close()
+	}()
+
+	if err := q.PushContext(context.Background(), 5); !errors.Is(err, ErrClosed) {
+		t.Errorf("push to a closed queue = %v, want %v", err, ErrClosed)
+	}
+
+	if v, ok := q.Pull(); v != 4 || !ok {
+		t.Errorf("Pull = %d, %v, want the value left", v, ok)
+	}
+
+	if _, ok := q.Pull(); ok {
+		t.Error("Pull of a closed, empty queue succeeded")
+	}
+}
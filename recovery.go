@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"mcAfkGo/bot/basic"
+	"mcAfkGo/bot/physics"
+	"mcAfkGo/maths"
+)
+
+const (
+	defaultRecoveryAttempts = 3
+
+	// recoveryReach is how close to its AFK spot the bot has to be to count
+	// as back.
+	recoveryReach = 2
+	// respawnTimeout is how long the server may take to place the bot after
+	// it respawned.
+	respawnTimeout = 30 * time.Second
+	// commandTimeout is how long the recovery command may take to teleport
+	// the bot.
+	commandTimeout = 10 * time.Second
+	// walkTimeout is how long a walk back may take at least, plus a second
+	// per block.
+	walkTimeout = 30 * time.Second
+)
+
+// returnToSpot brings the bot back to its AFK spot after it respawned
+// somewhere else, with the recovery command if one is configured and
+// walking the rest of the way. It raises an alert when the bot isn't back
+// after all attempts. ctx is canceled when the bot dies again.
+func (b *Instance) returnToSpot(ctx context.Context, player *basic.Player, ph *physics.Physics, spot maths.Vec3) {
+	back := func() bool { return ph.Position().DistanceTo(spot) <= recoveryReach }
+
+	for attempt := 1; attempt <= b.config.RecoveryAttempts; attempt++ {
+		if !b.waitFor(ctx, ph, respawnTimeout, ph.Ready) {
+			if ctx.Err() != nil || !b.session(ph) {
+				return
+			}
+
+			continue
+		}
+
+		if back() {
+			b.recovered(attempt)
+
+			return
+		}
+
+		pos := ph.Position()
+		b.logger.Printf("Respawned %.0f blocks from the AFK spot, returning (attempt %d of %d)",
+			pos.DistanceTo(spot), attempt, b.config.RecoveryAttempts)
+
+		if command := strings.TrimPrefix(b.config.RecoveryCommand, "/"); command != "" {
+			err := player.Command(command)
+			if err != nil {
+				b.logger.Printf("Failed to run recovery command, walking back: %v", err)
+			} else {
+				b.waitFor(ctx, ph, commandTimeout, func() bool { return ph.Position().DistanceTo(pos) > 1 })
+				if back() {
+					b.recovered(attempt)
+
+					return
+				}
+			}
+		}
+
+		err := ph.Goto(spot)
+		if err != nil {
+			b.logger.Printf("Can't walk back to the AFK spot: %v", err)
+		} else {
+			timeout := walkTimeout + time.Duration(ph.Position().DistanceTo(spot))*time.Second
+			arrived := b.waitFor(ctx, ph, timeout, func() bool {
+				_, walking := ph.Destination()
+
+				return !walking
+			})
+			if !arrived {
+				ph.StopGoto()
+			}
+		}
+
+		if ctx.Err() != nil || !b.session(ph) {
+			return
+		}
+
+		if back() {
+			b.recovered(attempt)
+
+			return
+		}
+	}
+
+	b.setAlert("not back at the AFK spot after dying")
+	b.logger.Printf("ALERT: failed to return to the AFK spot %.1f %.1f %.1f after %d attempts",
+		spot.X, spot.Y, spot.Z, b.config.RecoveryAttempts)
+}
+
+func (b *Instance) recovered(attempt int) {
+	b.setAlert("")
+
+	if attempt > 1 {
+		b.logger.Println("Back at the AFK spot")
+	}
+}
+
+// waitFor polls cond until it holds, and returns false if it doesn't within
+// timeout, ctx is canceled or ph stopped being the bot's physics.
+func (b *Instance) waitFor(ctx context.Context, ph *physics.Physics, timeout time.Duration, cond func() bool) bool {
+	ticker := time.NewTicker(time.Second / 4)
+	defer ticker.Stop()
+
+	deadline := time.After(timeout)
+
+	for !cond() {
+		if !b.session(ph) {
+			return false
+		}
+
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	return true
+}
+
+// session reports whether ph belongs to the bot's current, online session.
+func (b *Instance) session(ph *physics.Physics) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.physics == ph && b.state == StateOnline
+}
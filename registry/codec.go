@@ -7,8 +7,19 @@ import (
 	pk "mcAfkGo/net/packet"
 )
 
+// Registries are the registries the bot keeps. Registry data for others is
+// skipped.
 type Registries struct {
-	// Empty - all registries are ignored as they're not needed by the bot
+	DimensionType   Registry[DimensionType]   `registry:"minecraft:dimension_type"`
+	ChatType        Registry[ChatType]        `registry:"minecraft:chat_type"`
+	DamageType      Registry[DamageType]      `registry:"minecraft:damage_type"`
+	Biome           Registry[Biome]           `registry:"minecraft:worldgen/biome"`
+	WolfVariant     Registry[WolfVariant]     `registry:"minecraft:wolf_variant"`
+	PaintingVariant Registry[PaintingVariant] `registry:"minecraft:painting_variant"`
+	TrimPattern     Registry[TrimPattern]     `registry:"minecraft:trim_pattern"`
+	TrimMaterial    Registry[TrimMaterial]    `registry:"minecraft:trim_material"`
+	BannerPattern   Registry[BannerPattern]   `registry:"minecraft:banner_pattern"`
+	Enchantment     Registry[Enchantment]     `registry:"minecraft:enchantment"`
 }
 
 func NewNetworkCodec() Registries {
@@ -20,6 +31,7 @@ type RegistryCodec interface {
 	ReadTagsFrom(r io.Reader) (int64, error)
 }
 
+// Registry returns the registry named id, or nil if it isn't kept.
 func (c *Registries) Registry(id string) RegistryCodec {
 	codecVal := reflect.ValueOf(c).Elem()
 	codecTyp := codecVal.Type()
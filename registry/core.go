@@ -0,0 +1,113 @@
+package registry
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"io/fs"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"mcAfkGo/nbt"
+	pk "mcAfkGo/net/packet"
+)
+
+// The vanilla data pack whose registry contents are embedded. A server
+// offering it in a known packs request can omit the data of its entries.
+const (
+	CoreNamespace = "minecraft"
+	CoreID        = "core"
+)
+
+// CoreVersions are the versions of the core pack the embedded data is
+// taken from.
+var CoreVersions = []string{"1.21", "1.21.1"}
+
+// IsCorePack reports whether the embedded data matches the pack. The
+// server omits the data of every entry in a known pack, so the pack only
+// matches once each registry in Registries is embedded.
+func IsCorePack(namespace, id, version string) bool {
+	return namespace == CoreNamespace && id == CoreID && slices.Contains(CoreVersions, version) && coreComplete()
+}
+
+// core holds the entries of a registry in SNBT, a compound keyed by
+// entry, in core/<registry path>.snbt.
+//
+//go:embed core
+var core embed.FS
+
+func coreFile(registryID string) string {
+	return "core/" + strings.TrimPrefix(registryID, "minecraft:") + ".snbt"
+}
+
+// coreComplete reports whether every registry the bot keeps is embedded.
+var coreComplete = sync.OnceValue(func() bool {
+	typ := reflect.TypeFor[Registries]()
+	for i := 0; i < typ.NumField(); i++ {
+		registryID, ok := typ.Field(i).Tag.Lookup("registry")
+		if !ok {
+			continue
+		}
+
+		_, err := fs.Stat(core, coreFile(registryID))
+		if err != nil {
+			return false
+		}
+	}
+
+	return true
+})
+
+// UseCorePack makes entries the server sends without data take their
+// contents from the embedded core pack. It fails unless IsCorePack accepts
+// the pack.
+func (c *Registries) UseCorePack() error {
+	codecVal := reflect.ValueOf(c).Elem()
+	codecTyp := codecVal.Type()
+	for i := 0; i < codecVal.NumField(); i++ {
+		registryID, ok := codecTyp.Field(i).Tag.Lookup("registry")
+		if !ok {
+			continue
+		}
+
+		name := coreFile(registryID)
+		snbt, err := core.ReadFile(name)
+		if err != nil {
+			return errors.New("registry: " + err.Error())
+		}
+
+		err = codecVal.Field(i).Addr().Interface().(knownLoader).loadKnown(snbt)
+		if err != nil {
+			return errors.New("registry: " + name + ": " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+type knownLoader interface {
+	loadKnown(snbt []byte) error
+}
+
+func (r *Registry[E]) loadKnown(snbt []byte) error {
+	var buf bytes.Buffer
+	enc := nbt.NewEncoder(&buf)
+	enc.NetworkFormat(true)
+
+	err := enc.Encode(nbt.StringifiedMessage(snbt), "")
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]E)
+	_, err = pk.NBTField{V: &known, AllowUnknownFields: true}.ReadFrom(&buf)
+	if err != nil {
+		return err
+	}
+
+	r.known = known
+
+	return nil
+}
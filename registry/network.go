@@ -8,6 +8,9 @@ import (
 	pk "mcAfkGo/net/packet"
 )
 
+// ReadFrom replaces the entries with the ones of a registry data packet.
+// Entries sent without data, because the client knows them from a data
+// pack, take their known contents or keep the zero value.
 func (reg *Registry[E]) ReadFrom(r io.Reader) (int64, error) {
 	var length pk.VarInt
 	n, err := length.ReadFrom(r)
@@ -15,6 +18,8 @@ func (reg *Registry[E]) ReadFrom(r io.Reader) (int64, error) {
 		return n, err
 	}
 
+	reg.Clear()
+
 	var key pk.Identifier
 	var hasData pk.Boolean
 	for i := 0; i < int(length); i++ {
@@ -36,14 +41,18 @@ func (reg *Registry[E]) ReadFrom(r io.Reader) (int64, error) {
 			if err != nil {
 				return n + n1 + n2 + n3, err
 			}
+		} else {
+			data = reg.known[string(key)]
 		}
 
+		reg.Put(string(key), data)
 		n += n1 + n2 + n3
 	}
 
 	return n, nil
 }
 
+// ReadTagsFrom replaces the tags with the ones of an update tags packet.
 func (reg *Registry[E]) ReadTagsFrom(r io.Reader) (int64, error) {
 	var count pk.VarInt
 	n, err := count.ReadFrom(r)
@@ -51,6 +60,8 @@ func (reg *Registry[E]) ReadTagsFrom(r io.Reader) (int64, error) {
 		return n, err
 	}
 
+	reg.tags = make(map[string][]int32, count)
+
 	var tag pk.Identifier
 	var length pk.VarInt
 	for i := 0; i < int(count); i++ {
@@ -67,7 +78,7 @@ func (reg *Registry[E]) ReadTagsFrom(r io.Reader) (int64, error) {
 		}
 
 		n += n1 + n2
-		values := make([]*E, length)
+		ids := make([]int32, length)
 
 		var id pk.VarInt
 		for i := 0; i < int(length); i++ {
@@ -81,11 +92,11 @@ func (reg *Registry[E]) ReadTagsFrom(r io.Reader) (int64, error) {
 				return n + n3, err
 			}
 
-			values[i] = &reg.values[id]
+			ids[i] = int32(id)
 			n += n3
 		}
 
-		reg.tags[string(tag)] = values
+		reg.tags[string(tag)] = ids
 	}
 
 	return n, nil
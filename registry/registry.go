@@ -1,20 +1,89 @@
+// Package registry holds the data driven registries a server sends during
+// configuration. Other packets refer to their entries by network ID, which
+// is the order the server sent them in.
 package registry
 
+// Registry is a list of entries with their keys and tags. The zero value is
+// an empty registry.
 type Registry[E any] struct {
-	keys    map[string]int32
-	values  []E
-	indices map[*E]int32
-	tags    map[string][]*E
+	keys   map[string]int32
+	ids    []string
+	values []E
+	tags   map[string][]int32
+
+	// known has the contents of entries the server may omit.
+	known map[string]E
 }
 
+// Put appends an entry, giving it the next ID.
 func (r *Registry[E]) Put(key string, data E) (id int32, val *E) {
+	if r.keys == nil {
+		r.keys = make(map[string]int32)
+	}
+
 	id = int32(len(r.values))
 
 	r.keys[key] = id
+	r.ids = append(r.ids, key)
 	r.values = append(r.values, data)
 
 	val = &r.values[id]
-	r.indices[val] = id
 
 	return
 }
+
+// Clear removes all entries and tags, but keeps the known contents.
+func (r *Registry[E]) Clear() {
+	r.keys = nil
+	r.ids = nil
+	r.values = nil
+	r.tags = nil
+}
+
+func (r *Registry[E]) Len() int {
+	return len(r.values)
+}
+
+// Get returns the ID and entry of key, or -1 and nil.
+func (r *Registry[E]) Get(key string) (int32, *E) {
+	id, ok := r.keys[key]
+	if !ok {
+		return -1, nil
+	}
+
+	return id, &r.values[id]
+}
+
+// GetByID returns the entry with the network ID id, or nil.
+func (r *Registry[E]) GetByID(id int32) *E {
+	if id < 0 || int(id) >= len(r.values) {
+		return nil
+	}
+
+	return &r.values[id]
+}
+
+// Key returns the key of the entry with the network ID id, or "".
+func (r *Registry[E]) Key(id int32) string {
+	if id < 0 || int(id) >= len(r.ids) {
+		return ""
+	}
+
+	return r.ids[id]
+}
+
+// Tag returns the IDs of the entries in tag, given without the leading #.
+func (r *Registry[E]) Tag(tag string) []int32 {
+	return r.tags[tag]
+}
+
+// HasTag reports whether the entry with the network ID id is in tag.
+func (r *Registry[E]) HasTag(tag string, id int32) bool {
+	for _, v := range r.tags[tag] {
+		if v == id {
+			return true
+		}
+	}
+
+	return false
+}
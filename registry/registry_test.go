@@ -0,0 +1,192 @@
+package registry
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	pk "mcAfkGo/net/packet"
+)
+
+// entry is an entry of a registry data packet, sent without data if nil.
+type entry struct {
+	key  string
+	data any
+}
+
+func registryData(t *testing.T, entries ...entry) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	fields := []pk.FieldEncoder{pk.VarInt(len(entries))}
+	for _, e := range entries {
+		fields = append(fields, pk.Identifier(e.key), pk.Boolean(e.data != nil))
+		if e.data != nil {
+			fields = append(fields, pk.NBTField{V: e.data})
+		}
+	}
+
+	for _, f := range fields {
+		if _, err := f.WriteTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &buf
+}
+
+func TestRegistryReadFrom(t *testing.T) {
+	known := map[string]PaintingVariant{
+		"minecraft:kebab": {AssetID: "minecraft:kebab", Width: 1, Height: 1},
+	}
+
+	tests := []struct {
+		name    string
+		entries []entry
+		want    []PaintingVariant
+	}{
+		{
+			name: "with data",
+			entries: []entry{
+				{"minecraft:kebab", PaintingVariant{AssetID: "minecraft:kebab", Width: 2, Height: 2}},
+				{"minecraft:aztec", PaintingVariant{AssetID: "minecraft:aztec", Width: 1, Height: 1}},
+			},
+			want: []PaintingVariant{
+				{AssetID: "minecraft:kebab", Width: 2, Height: 2},
+				{AssetID: "minecraft:aztec", Width: 1, Height: 1},
+			},
+		},
+		{
+			name:    "known data",
+			entries: []entry{{"minecraft:aztec", nil}, {"minecraft:kebab", nil}},
+			want:    []PaintingVariant{{}, known["minecraft:kebab"]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := Registry[PaintingVariant]{known: known}
+			reg.Put("minecraft:stale", PaintingVariant{})
+
+			if _, err := reg.ReadFrom(registryData(t, tt.entries...)); err != nil {
+				t.Fatalf("ReadFrom: %v", err)
+			}
+
+			if reg.Len() != len(tt.want) {
+				t.Fatalf("Len = %d, want %d", reg.Len(), len(tt.want))
+			}
+
+			if id, _ := reg.Get("minecraft:stale"); id != -1 {
+				t.Errorf("Get(stale) = %d, want -1", id)
+			}
+
+			for i, e := range tt.entries {
+				id, val := reg.Get(e.key)
+				if id != int32(i) || val == nil || *val != tt.want[i] {
+					t.Errorf("Get(%s) = %d, %v, want %d, %v", e.key, id, val, i, tt.want[i])
+				}
+
+				if got := reg.GetByID(int32(i)); got == nil || *got != tt.want[i] {
+					t.Errorf("GetByID(%d) = %v, want %v", i, got, tt.want[i])
+				}
+
+				if got := reg.Key(int32(i)); got != e.key {
+					t.Errorf("Key(%d) = %q, want %q", i, got, e.key)
+				}
+			}
+
+			n := int32(len(tt.entries))
+			if reg.GetByID(-1) != nil || reg.GetByID(n) != nil || reg.Key(n) != "" {
+				t.Errorf("IDs out of range return entries")
+			}
+		})
+	}
+}
+
+func TestRegistryReadTagsFrom(t *testing.T) {
+	tags := func(ids ...int) *bytes.Buffer {
+		var buf bytes.Buffer
+		fields := []pk.FieldEncoder{pk.VarInt(1), pk.Identifier("minecraft:placeable"), pk.VarInt(len(ids))}
+		for _, id := range ids {
+			fields = append(fields, pk.VarInt(id))
+		}
+
+		for _, f := range fields {
+			if _, err := f.WriteTo(&buf); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		return &buf
+	}
+
+	tests := []struct {
+		name    string
+		tags    *bytes.Buffer
+		want    []int32
+		wantErr string
+	}{
+		{name: "tag", tags: tags(2, 0), want: []int32{2, 0}},
+		{name: "empty tag", tags: tags(), want: []int32{}},
+		{name: "invalid id", tags: tags(0, 3), wantErr: "invalid id: 3"},
+		{name: "negative id", tags: tags(-1), wantErr: "invalid id: -1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reg Registry[PaintingVariant]
+			for _, key := range []string{"minecraft:kebab", "minecraft:aztec", "minecraft:alban"} {
+				reg.Put(key, PaintingVariant{AssetID: key})
+			}
+
+			_, err := reg.ReadTagsFrom(tt.tags)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ReadTagsFrom = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("ReadTagsFrom: %v", err)
+			}
+
+			if got := reg.Tag("minecraft:placeable"); !slices.Equal(got, tt.want) {
+				t.Errorf("Tag = %v, want %v", got, tt.want)
+			}
+
+			for id := int32(0); id < 3; id++ {
+				if got, want := reg.HasTag("minecraft:placeable", id), slices.Contains(tt.want, id); got != want {
+					t.Errorf("HasTag(%d) = %v, want %v", id, got, want)
+				}
+			}
+
+			if reg.HasTag("minecraft:unknown", 0) {
+				t.Errorf("HasTag(unknown) = true")
+			}
+		})
+	}
+}
+
+func TestRegistriesRegistry(t *testing.T) {
+	c := NewNetworkCodec()
+
+	tests := []struct {
+		id   string
+		want RegistryCodec
+	}{
+		{id: "minecraft:dimension_type", want: &c.DimensionType},
+		{id: "minecraft:chat_type", want: &c.ChatType},
+		{id: "minecraft:worldgen/biome", want: &c.Biome},
+		{id: "minecraft:enchantment", want: &c.Enchantment},
+		{id: "minecraft:jukebox_song"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			if got := c.Registry(tt.id); got != tt.want {
+				t.Errorf("Registry(%s) = %p, want %p", tt.id, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package registry
+
+import (
+	"mcAfkGo/chat"
+	"mcAfkGo/nbt"
+)
+
+// The entry types follow the registry data of protocol 767. Fields that can
+// take several shapes, such as int providers or holder sets, are kept raw.
+
+type DimensionType struct {
+	FixedTime                   *int64         `nbt:"fixed_time,omitempty"`
+	HasSkylight                 bool           `nbt:"has_skylight"`
+	HasCeiling                  bool           `nbt:"has_ceiling"`
+	Ultrawarm                   bool           `nbt:"ultrawarm"`
+	Natural                     bool           `nbt:"natural"`
+	CoordinateScale             float64        `nbt:"coordinate_scale"`
+	BedWorks                    bool           `nbt:"bed_works"`
+	RespawnAnchorWorks          bool           `nbt:"respawn_anchor_works"`
+	MinY                        int32          `nbt:"min_y"`
+	Height                      int32          `nbt:"height"`
+	LogicalHeight               int32          `nbt:"logical_height"`
+	Infiniburn                  string         `nbt:"infiniburn"`
+	Effects                     string         `nbt:"effects"`
+	AmbientLight                float32        `nbt:"ambient_light"`
+	PiglinSafe                  bool           `nbt:"piglin_safe"`
+	HasRaids                    bool           `nbt:"has_raids"`
+	MonsterSpawnLightLevel      nbt.RawMessage `nbt:"monster_spawn_light_level"`
+	MonsterSpawnBlockLightLimit int32          `nbt:"monster_spawn_block_light_limit"`
+}
+
+type ChatType struct {
+	Chat      chat.Decoration `nbt:"chat"`
+	Narration chat.Decoration `nbt:"narration"`
+}
+
+type DamageType struct {
+	MessageID        string  `nbt:"message_id"`
+	Scaling          string  `nbt:"scaling"`
+	Exhaustion       float32 `nbt:"exhaustion"`
+	Effects          string  `nbt:"effects,omitempty"`
+	DeathMessageType string  `nbt:"death_message_type,omitempty"`
+}
+
+// DeathMessageKey is the translation key of the death message of a player
+// killed by the damage type, with the killer if byKiller.
+func (d DamageType) DeathMessageKey(byKiller bool) string {
+	key := "death.attack." + d.MessageID
+	if d.DeathMessageType == "intentional_game_design" {
+		return key + ".message"
+	}
+
+	if byKiller {
+		key += ".player"
+	}
+
+	return key
+}
+
+type Biome struct {
+	HasPrecipitation    bool    `nbt:"has_precipitation"`
+	Temperature         float32 `nbt:"temperature"`
+	TemperatureModifier string  `nbt:"temperature_modifier,omitempty"`
+	Downfall            float32 `nbt:"downfall"`
+	Effects             struct {
+		FogColor           int32  `nbt:"fog_color"`
+		WaterColor         int32  `nbt:"water_color"`
+		WaterFogColor      int32  `nbt:"water_fog_color"`
+		SkyColor           int32  `nbt:"sky_color"`
+		FoliageColor       *int32 `nbt:"foliage_color,omitempty"`
+		GrassColor         *int32 `nbt:"grass_color,omitempty"`
+		GrassColorModifier string `nbt:"grass_color_modifier,omitempty"`
+	} `nbt:"effects"`
+}
+
+type WolfVariant struct {
+	WildTexture  string         `nbt:"wild_texture"`
+	TameTexture  string         `nbt:"tame_texture"`
+	AngryTexture string         `nbt:"angry_texture"`
+	Biomes       nbt.RawMessage `nbt:"biomes"`
+}
+
+type PaintingVariant struct {
+	AssetID string `nbt:"asset_id"`
+	Width   int32  `nbt:"width"`
+	Height  int32  `nbt:"height"`
+}
+
+type TrimPattern struct {
+	AssetID      string       `nbt:"asset_id"`
+	TemplateItem string       `nbt:"template_item"`
+	Description  chat.Message `nbt:"description"`
+	Decal        bool         `nbt:"decal"`
+}
+
+type TrimMaterial struct {
+	AssetName              string            `nbt:"asset_name"`
+	Ingredient             string            `nbt:"ingredient"`
+	ItemModelIndex         float32           `nbt:"item_model_index"`
+	OverrideArmorMaterials map[string]string `nbt:"override_armor_materials,omitempty"`
+	Description            chat.Message      `nbt:"description"`
+}
+
+type BannerPattern struct {
+	AssetID        string `nbt:"asset_id"`
+	TranslationKey string `nbt:"translation_key"`
+}
+
+type Enchantment struct {
+	Description    chat.Message    `nbt:"description"`
+	SupportedItems nbt.RawMessage  `nbt:"supported_items"`
+	PrimaryItems   nbt.RawMessage  `nbt:"primary_items,omitempty"`
+	Weight         int32           `nbt:"weight"`
+	MaxLevel       int32           `nbt:"max_level"`
+	MinCost        EnchantmentCost `nbt:"min_cost"`
+	MaxCost        EnchantmentCost `nbt:"max_cost"`
+	AnvilCost      int32           `nbt:"anvil_cost"`
+	Slots          []string        `nbt:"slots"`
+	ExclusiveSet   nbt.RawMessage  `nbt:"exclusive_set,omitempty"`
+	Effects        nbt.RawMessage  `nbt:"effects,omitempty"`
+}
+
+type EnchantmentCost struct {
+	Base               int32 `nbt:"base"`
+	PerLevelAboveFirst int32 `nbt:"per_level_above_first"`
+}